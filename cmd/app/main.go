@@ -1,19 +1,57 @@
 package main
 
 import (
+	"context"
 	"dennic_user_service/internal/app"
+	auditRepo "dennic_user_service/internal/infrastructure/repository/postgresql/audit"
+	roleRepo "dennic_user_service/internal/infrastructure/repository/postgresql/role"
+	userRepo "dennic_user_service/internal/infrastructure/repository/postgresql/user"
+	pkgapp "dennic_user_service/internal/pkg/app"
 	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/migrate"
+	"dennic_user_service/internal/pkg/postgres"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"dennic_user_service/internal/usecase"
+	"dennic_user_service/migrations"
+	"errors"
+	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"os/signal"
+	"sort"
+	"strconv"
 	"syscall"
 
 	"go.uber.org/zap"
 )
 
+const usage = `usage: dennic_user_service [command]
+
+commands:
+  (none)        run the gRPC service
+  config print        print the effective configuration with secrets masked
+  migrate up          apply every pending migration
+  migrate down [n]    roll back the last n migrations (default 1)
+  migrate status      print the current and the latest schema version
+  migrate force <v>   mark version v as applied and clean after a failed migration
+  fixtures load       load the development fixtures, refused in production
+  users import <file.csv> [--dry-run]
+                      import users from a CSV and print a report per row`
+
 func main() {
 	// initialization config
-	config := config.New()
+	config, err := config.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(os.Args) > 1 {
+		if err := runCommand(config, os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
 	// initialization app
 	app, err := app.NewApp(config)
@@ -28,10 +66,17 @@ func main() {
 		}
 	}()
 
-	// graceful shutdown
+	// SIGHUP reloads the log level, SIGINT and SIGTERM shut down gracefully
 	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-	<-sigs
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	for sig := range sigs {
+		if sig != syscall.SIGHUP {
+			break
+		}
+		if err := app.ReloadLogLevel(); err != nil {
+			app.Logger.Error("reload log level", zap.Error(err))
+		}
+	}
 
 	app.Logger.Info("Conten service stops !")
 
@@ -39,3 +84,140 @@ func main() {
 	app.Stop()
 
 }
+
+func runCommand(cfg *config.Config, args []string) error {
+	switch {
+	case len(args) == 2 && args[0] == "config" && args[1] == "print":
+		return cfg.Print(os.Stdout)
+	case len(args) >= 2 && args[0] == "migrate":
+		return runMigrate(cfg, args[1:])
+	case len(args) == 2 && args[0] == "fixtures" && args[1] == "load":
+		return loadFixtures(cfg)
+	case len(args) >= 3 && len(args) <= 4 && args[0] == "users" && args[1] == "import":
+		return importUsers(cfg, args[2:])
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args, usage)
+	}
+}
+
+func runMigrate(cfg *config.Config, args []string) error {
+	ctx := context.Background()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(args) == 1 && args[0] == "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case len(args) <= 2 && args[0] == "down":
+		steps := 1
+		if len(args) == 2 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+	case len(args) == 1 && args[0] == "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("current: %d\nlatest: %d\ndirty: %t\n", status.Current, status.Latest, status.Dirty)
+		for _, migration := range status.Pending {
+			fmt.Printf("pending %d_%s\n", migration.Version, migration.Name)
+		}
+		return nil
+	case len(args) == 2 && args[0] == "force":
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		return migrator.Force(ctx, version)
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args, usage)
+	}
+}
+
+func loadFixtures(cfg *config.Config) error {
+	if cfg.Environment == pkgapp.EnvironmentProduction {
+		return errors.New("fixtures are not loaded in production")
+	}
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	fixtures, err := fs.Sub(migrations.Fixtures, "fixtures")
+	if err != nil {
+		return err
+	}
+
+	loaded, err := migrate.LoadFixtures(context.Background(), db, fixtures)
+	for _, name := range loaded {
+		fmt.Printf("loaded %s\n", name)
+	}
+	return err
+}
+
+func importUsers(cfg *config.Config, args []string) error {
+	dryRun := len(args) == 2
+	if dryRun && args[1] != "--dry-run" {
+		return fmt.Errorf("unknown import flag %q\n%s", args[1], usage)
+	}
+
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	db, err := postgres.New(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// the command runs as an internal caller, which holds every permission once marked as one
+	roles := usecase.NewRoleService(cfg.Context.Timeout, roleRepo.NewRoleRepo(db), 0)
+	users := usecase.NewUserService(cfg.Context.Timeout, userRepo.NewUserRepo(db), auditRepo.NewAuditRepo(db), roles)
+
+	report, err := users.ImportUsers(reqinfo.Internal(context.Background()), file, dryRun)
+	if err != nil {
+		return err
+	}
+	for _, row := range report.Rows {
+		fmt.Printf("line %d: %s %s", row.Line, row.Status, row.PhoneNumber)
+		if row.UserId != "" {
+			fmt.Printf(" %s", row.UserId)
+		}
+		fields := make([]string, 0, len(row.Errors))
+		for field := range row.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			fmt.Printf("\n  %s: %s", field, row.Errors[field])
+		}
+		fmt.Println()
+	}
+	fmt.Printf("imported: %d\nduplicates: %d\nfailed: %d\ndry run: %t\n", report.Imported, report.Duplicates, report.Failed, report.DryRun)
+	return nil
+}
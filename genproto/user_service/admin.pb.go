@@ -7,6 +7,7 @@ import (
 	context "context"
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
@@ -29,25 +30,31 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Admin struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	AdminOrder           int64    `protobuf:"varint,2,opt,name=admin_order,json=adminOrder,proto3" json:"admin_order"`
-	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
-	FirstName            string   `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name"`
-	LastName             string   `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name"`
-	BirthDate            string   `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
-	PhoneNumber          string   `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
-	Email                string   `protobuf:"bytes,8,opt,name=email,proto3" json:"email"`
-	Password             string   `protobuf:"bytes,9,opt,name=password,proto3" json:"password"`
-	Gender               string   `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender"`
-	Salary               float32  `protobuf:"fixed32,11,opt,name=salary,proto3" json:"salary"`
-	Biography            string   `protobuf:"bytes,12,opt,name=biography,proto3" json:"biography"`
-	StartWorkYear        string   `protobuf:"bytes,13,opt,name=start_work_year,json=startWorkYear,proto3" json:"start_work_year"`
-	EndWorkYear          string   `protobuf:"bytes,14,opt,name=end_work_year,json=endWorkYear,proto3" json:"end_work_year"`
-	WorkYears            uint64   `protobuf:"varint,15,opt,name=work_years,json=workYears,proto3" json:"work_years"`
-	RefreshToken         string   `protobuf:"bytes,16,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
-	CreatedAt            string   `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt            string   `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	DeletedAt            string   `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AdminOrder    int64   `protobuf:"varint,2,opt,name=admin_order,json=adminOrder,proto3" json:"admin_order"`
+	Role          string  `protobuf:"bytes,3,opt,name=role,proto3" json:"role"`
+	FirstName     string  `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name"`
+	LastName      string  `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name"`
+	BirthDate     string  `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date"`
+	PhoneNumber   string  `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
+	Email         string  `protobuf:"bytes,8,opt,name=email,proto3" json:"email"`
+	Password      string  `protobuf:"bytes,9,opt,name=password,proto3" json:"password"`
+	Gender        string  `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender"`
+	Salary        float32 `protobuf:"fixed32,11,opt,name=salary,proto3" json:"salary"`
+	Biography     string  `protobuf:"bytes,12,opt,name=biography,proto3" json:"biography"`
+	StartWorkYear string  `protobuf:"bytes,13,opt,name=start_work_year,json=startWorkYear,proto3" json:"start_work_year"`
+	EndWorkYear   string  `protobuf:"bytes,14,opt,name=end_work_year,json=endWorkYear,proto3" json:"end_work_year"`
+	// derived from start_work_year and end_work_year, it is ignored on writes
+	WorkYears    uint64 `protobuf:"varint,15,opt,name=work_years,json=workYears,proto3" json:"work_years"`
+	RefreshToken string `protobuf:"bytes,16,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token"`
+	CreatedAt    string `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt    string `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	DeletedAt    string `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at"`
+	Version      uint64 `protobuf:"varint,20,opt,name=version,proto3" json:"version"`
+	// fields changed by Update, when empty every non-empty field is written
+	UpdateMask *types.FieldMask `protobuf:"bytes,21,opt,name=update_mask,json=updateMask,proto3" json:"update_mask"`
+	// set when the employment ended, a terminated admin can not log in
+	TerminatedAt         string   `protobuf:"bytes,22,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -219,6 +226,27 @@ func (m *Admin) GetDeletedAt() string {
 	return ""
 }
 
+func (m *Admin) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Admin) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *Admin) GetTerminatedAt() string {
+	if m != nil {
+		return m.TerminatedAt
+	}
+	return ""
+}
+
 type IfAdminExistsReq struct {
 	PhoneNumber          string   `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number"`
 	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email"`
@@ -847,1432 +875,8041 @@ func (m *UpdateRefreshTokenAdminResp) GetStatus() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Admin)(nil), "user.Admin")
-	proto.RegisterType((*IfAdminExistsReq)(nil), "user.IfAdminExistsReq")
-	proto.RegisterType((*GetAdminReqById)(nil), "user.GetAdminReqById")
-	proto.RegisterType((*ListAdminsReq)(nil), "user.ListAdminsReq")
-	proto.RegisterMapType((map[string]string)(nil), "user.ListAdminsReq.FilterEntry")
-	proto.RegisterType((*ListAdminsResp)(nil), "user.ListAdminsResp")
-	proto.RegisterType((*ChangeAdminPasswordReq)(nil), "user.ChangeAdminPasswordReq")
-	proto.RegisterType((*DeleteAdminReq)(nil), "user.DeleteAdminReq")
-	proto.RegisterType((*ChangeAdminPasswordResp)(nil), "user.ChangeAdminPasswordResp")
-	proto.RegisterType((*CheckAdminFieldReq)(nil), "user.CheckAdminFieldReq")
-	proto.RegisterType((*CheckAdminFieldResp)(nil), "user.CheckAdminFieldResp")
-	proto.RegisterType((*IfAdminExistsResp)(nil), "user.IfAdminExistsResp")
-	proto.RegisterType((*UpdateRefreshTokenAdminReq)(nil), "user.UpdateRefreshTokenAdminReq")
-	proto.RegisterType((*UpdateRefreshTokenAdminResp)(nil), "user.UpdateRefreshTokenAdminResp")
+type RestoreAdminReq struct {
+	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("user_service/admin.proto", fileDescriptor_cc32bb425e570901) }
-
-var fileDescriptor_cc32bb425e570901 = []byte{
-	// 899 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0x46, 0xb6, 0xe3, 0xda, 0xc7, 0xb1, 0x93, 0x6e, 0x42, 0xaa, 0x2a, 0x4d, 0xea, 0xaa, 0x33,
-	0x8c, 0x19, 0xc0, 0x81, 0x32, 0x50, 0x7e, 0x6e, 0x48, 0xd3, 0xb4, 0x93, 0x29, 0x14, 0x10, 0x30,
-	0x4c, 0xaf, 0x34, 0xeb, 0xe8, 0xc8, 0xd6, 0x58, 0x96, 0xd4, 0xdd, 0x75, 0x82, 0xdf, 0x84, 0x0b,
-	0x5e, 0x82, 0x2b, 0x5e, 0x81, 0x4b, 0x1e, 0x81, 0x09, 0x2f, 0xc2, 0xec, 0xd9, 0x55, 0xb0, 0xe3,
-	0xc4, 0x5c, 0xf4, 0x4e, 0xe7, 0xfb, 0xbe, 0x3d, 0x7b, 0x76, 0xcf, 0x77, 0x56, 0xe0, 0x4e, 0x25,
-	0x8a, 0x50, 0xa2, 0x38, 0x4b, 0x4e, 0xf1, 0x80, 0x47, 0x93, 0x24, 0xeb, 0x17, 0x22, 0x57, 0x39,
-	0xab, 0x69, 0xc6, 0xdb, 0x1d, 0xe6, 0xf9, 0x30, 0xc5, 0x03, 0xc2, 0x06, 0xd3, 0xf8, 0x00, 0x27,
-	0x85, 0x9a, 0x19, 0x89, 0xff, 0x47, 0x0d, 0xd6, 0x0e, 0xf5, 0x12, 0xd6, 0x81, 0x4a, 0x12, 0xb9,
-	0x4e, 0xd7, 0xe9, 0x35, 0x83, 0x4a, 0x12, 0xb1, 0xfb, 0xd0, 0xa2, 0x5c, 0x61, 0x2e, 0x22, 0x14,
-	0x6e, 0xa5, 0xeb, 0xf4, 0xaa, 0x01, 0x10, 0xf4, 0xad, 0x46, 0x18, 0x83, 0x9a, 0xc8, 0x53, 0x74,
-	0xab, 0xb4, 0x84, 0xbe, 0xd9, 0x1e, 0x40, 0x9c, 0x08, 0xa9, 0xc2, 0x8c, 0x4f, 0xd0, 0xad, 0x11,
-	0xd3, 0x24, 0xe4, 0x25, 0x9f, 0x20, 0xdb, 0x85, 0x66, 0xca, 0x4b, 0x76, 0x8d, 0xd8, 0x86, 0x06,
-	0x88, 0xdc, 0x03, 0x18, 0x24, 0x42, 0x8d, 0xc2, 0x88, 0x2b, 0x74, 0xeb, 0x66, 0x2d, 0x21, 0x4f,
-	0xb9, 0x42, 0xf6, 0x00, 0xd6, 0x8b, 0x51, 0x9e, 0x61, 0x98, 0x4d, 0x27, 0x03, 0x14, 0xee, 0x2d,
-	0x12, 0xb4, 0x08, 0x7b, 0x49, 0x10, 0xdb, 0x86, 0x35, 0x9c, 0xf0, 0x24, 0x75, 0x1b, 0xc4, 0x99,
-	0x80, 0x79, 0xd0, 0x28, 0xb8, 0x94, 0xe7, 0xb9, 0x88, 0xdc, 0xa6, 0xd9, 0xb3, 0x8c, 0xd9, 0x0e,
-	0xd4, 0x87, 0x98, 0xe9, 0xf3, 0x01, 0x31, 0x36, 0xd2, 0xb8, 0xe4, 0x29, 0x17, 0x33, 0xb7, 0xd5,
-	0x75, 0x7a, 0x95, 0xc0, 0x46, 0xec, 0x1e, 0x34, 0x07, 0x49, 0x3e, 0x14, 0xbc, 0x18, 0xcd, 0xdc,
-	0xf5, 0xb2, 0x44, 0x0b, 0xb0, 0x77, 0x60, 0x43, 0x2a, 0x2e, 0x54, 0x78, 0x9e, 0x8b, 0x71, 0x38,
-	0x43, 0x2e, 0xdc, 0x36, 0x69, 0xda, 0x04, 0xff, 0x9c, 0x8b, 0xf1, 0x2b, 0xe4, 0x82, 0xf9, 0xd0,
-	0xc6, 0x2c, 0x9a, 0x53, 0x75, 0xcc, 0x59, 0x30, 0x8b, 0x2e, 0x35, 0x7b, 0x00, 0x97, 0xbc, 0x74,
-	0x37, 0xba, 0x4e, 0xaf, 0x16, 0x34, 0xcf, 0x2d, 0x2b, 0xd9, 0x43, 0x68, 0x0b, 0x8c, 0x05, 0xca,
-	0x51, 0xa8, 0xf2, 0x31, 0x66, 0xee, 0x26, 0xa5, 0x58, 0xb7, 0xe0, 0x8f, 0x1a, 0xd3, 0x39, 0x4e,
-	0x05, 0x72, 0x85, 0x51, 0xc8, 0x95, 0x7b, 0xdb, 0x94, 0x6b, 0x91, 0x43, 0xa5, 0xe9, 0x69, 0x11,
-	0x95, 0x34, 0x33, 0xb4, 0x45, 0x0c, 0x1d, 0x61, 0x8a, 0x96, 0xde, 0x32, 0xb4, 0x45, 0x0e, 0x95,
-	0xff, 0x02, 0x36, 0x4f, 0x62, 0xb2, 0xce, 0xf1, 0x2f, 0x89, 0x54, 0x32, 0xc0, 0xd7, 0x4b, 0x3d,
-	0x72, 0x56, 0xf4, 0xa8, 0x32, 0xd7, 0x23, 0xff, 0x7d, 0xd8, 0x78, 0x8e, 0x8a, 0xb2, 0x05, 0xf8,
-	0xfa, 0xc9, 0xec, 0x24, 0x62, 0x77, 0xa1, 0x61, 0xfc, 0x77, 0xe9, 0xca, 0x5b, 0x14, 0x9f, 0x44,
-	0xfe, 0xef, 0x0e, 0xb4, 0xbf, 0x4e, 0xa4, 0xd1, 0xd3, 0xc6, 0xdb, 0xb0, 0x96, 0x26, 0x93, 0x44,
-	0x91, 0xb2, 0x16, 0x98, 0x40, 0x77, 0x31, 0x8f, 0x63, 0x89, 0x8a, 0x36, 0xab, 0x05, 0x36, 0x62,
-	0x8f, 0xa1, 0x1e, 0x27, 0xa9, 0x42, 0xe1, 0x56, 0xbb, 0xd5, 0x5e, 0xeb, 0xd1, 0xfd, 0xbe, 0x1e,
-	0x94, 0xfe, 0x42, 0xca, 0xfe, 0x33, 0x52, 0x1c, 0x67, 0x4a, 0xcc, 0x02, 0x2b, 0xf7, 0x3e, 0x87,
-	0xd6, 0x1c, 0xcc, 0x36, 0xa1, 0x3a, 0xc6, 0x99, 0xad, 0x4e, 0x7f, 0xea, 0x3a, 0xce, 0x78, 0x3a,
-	0xc5, 0xf2, 0x74, 0x14, 0x7c, 0x51, 0xf9, 0xcc, 0xf1, 0x5f, 0x40, 0x67, 0x3e, 0xbf, 0x2c, 0xd8,
-	0x43, 0xa8, 0xd3, 0x81, 0xa4, 0xeb, 0x50, 0x15, 0x2d, 0x53, 0x85, 0xb9, 0x04, 0x4b, 0xe9, 0x84,
-	0xa7, 0xf9, 0x34, 0x2b, 0x4f, 0x60, 0x02, 0x7f, 0x02, 0x3b, 0x47, 0x23, 0x9e, 0x0d, 0x91, 0xc4,
-	0xdf, 0x59, 0x37, 0xbf, 0x49, 0x07, 0x16, 0xa6, 0xa4, 0xba, 0x38, 0x25, 0xfe, 0x7b, 0xd0, 0x79,
-	0x4a, 0x7d, 0x2f, 0x1b, 0xb4, 0xaa, 0x39, 0x1f, 0xc1, 0x9d, 0x6b, 0x6b, 0x93, 0x05, 0x4d, 0x95,
-	0xe2, 0x6a, 0x2a, 0x69, 0x4d, 0x23, 0xb0, 0x91, 0xff, 0x15, 0xb0, 0xa3, 0x11, 0x9e, 0x8e, 0x69,
-	0xc5, 0xb3, 0x04, 0xd3, 0xc8, 0xf6, 0xd4, 0xdc, 0xa5, 0x33, 0x77, 0x97, 0x1a, 0x8d, 0xb5, 0xa2,
-	0xac, 0x9e, 0x02, 0xff, 0x03, 0xd8, 0x5a, 0xca, 0xb0, 0x62, 0xc3, 0x0f, 0xe1, 0xf6, 0x15, 0xef,
-	0xca, 0x42, 0x3f, 0x4e, 0x89, 0x0c, 0x91, 0x00, 0xab, 0x6f, 0x24, 0xd2, 0x08, 0xfc, 0xef, 0xc1,
-	0xfb, 0x89, 0x26, 0x23, 0x98, 0x1b, 0xb0, 0xcb, 0xeb, 0xb8, 0xfa, 0x76, 0x2e, 0x4d, 0x67, 0x65,
-	0x79, 0x3a, 0xfd, 0x4f, 0x60, 0xf7, 0xc6, 0x94, 0x37, 0xd7, 0xfe, 0xe8, 0xb7, 0x1a, 0xac, 0x93,
-	0xea, 0x07, 0xf3, 0xe2, 0x33, 0x1f, 0xea, 0x47, 0x34, 0xd3, 0x6c, 0xde, 0x41, 0xde, 0x7c, 0xa0,
-	0x35, 0x66, 0xaf, 0x15, 0x9a, 0x77, 0xa1, 0xfa, 0x1c, 0x15, 0x7b, 0xdb, 0x60, 0x57, 0xc6, 0x71,
-	0x51, 0xfa, 0x18, 0xe0, 0x3f, 0x33, 0xb3, 0xad, 0x6b, 0xc6, 0xc7, 0xdb, 0x5e, 0x06, 0x65, 0xc1,
-	0x3e, 0x85, 0xba, 0x71, 0x12, 0xb3, 0xfc, 0xa2, 0xaf, 0xbc, 0x9d, 0xbe, 0xf9, 0x59, 0xf5, 0xcb,
-	0x9f, 0x55, 0xff, 0x58, 0xff, 0xac, 0xd8, 0x21, 0x00, 0xf5, 0x97, 0x5a, 0xcb, 0x5c, 0xb3, 0x76,
-	0xd9, 0x33, 0xde, 0xdd, 0x1b, 0x18, 0x59, 0xb0, 0x2f, 0xa1, 0x71, 0x12, 0x9b, 0x6e, 0xb2, 0x1d,
-	0x23, 0xbb, 0xfa, 0x7e, 0x79, 0x77, 0xae, 0xc5, 0x65, 0xc1, 0xbe, 0x81, 0x8e, 0x31, 0x75, 0xe9,
-	0x67, 0x76, 0xaf, 0xdc, 0xe9, 0xba, 0x31, 0xf4, 0xf6, 0x56, 0xb0, 0xb2, 0x60, 0xaf, 0x80, 0x2d,
-	0xb7, 0x9e, 0x75, 0xcd, 0xa2, 0x9b, 0x7d, 0xe6, 0x3d, 0xf8, 0x1f, 0x85, 0x2c, 0x9e, 0x6c, 0xfe,
-	0x79, 0xb1, 0xef, 0xfc, 0x75, 0xb1, 0xef, 0xfc, 0x7d, 0xb1, 0xef, 0xfc, 0xfa, 0xcf, 0xfe, 0x5b,
-	0x83, 0x3a, 0xdd, 0xe5, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x28, 0xd8, 0xde, 0x28,
-	0x08, 0x00, 0x00,
+func (m *RestoreAdminReq) Reset()         { *m = RestoreAdminReq{} }
+func (m *RestoreAdminReq) String() string { return proto.CompactTextString(m) }
+func (*RestoreAdminReq) ProtoMessage()    {}
+func (*RestoreAdminReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{13}
+}
+func (m *RestoreAdminReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreAdminReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreAdminReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreAdminReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreAdminReq.Merge(m, src)
+}
+func (m *RestoreAdminReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreAdminReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreAdminReq.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_RestoreAdminReq proto.InternalMessageInfo
 
-// AdminServiceClient is the client API for AdminService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminServiceClient interface {
-	Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
-	Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
-	Get(ctx context.Context, in *GetAdminReqById, opts ...grpc.CallOption) (*Admin, error)
-	ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error)
-	Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*empty.Empty, error)
-	CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error)
-	IfExists(ctx context.Context, in *IfAdminExistsReq, opts ...grpc.CallOption) (*IfAdminExistsResp, error)
-	ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
-	UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error)
+func (m *RestoreAdminReq) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
 }
 
-type adminServiceClient struct {
-	cc *grpc.ClientConn
+// filter keys: phone_number, email, deleted_after and deleted_before (RFC 3339)
+type ListDeletedAdminsReq struct {
+	Limit                uint64            `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Offset               uint64            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset"`
+	Filter               map[string]string `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
-	return &adminServiceClient{cc}
+func (m *ListDeletedAdminsReq) Reset()         { *m = ListDeletedAdminsReq{} }
+func (m *ListDeletedAdminsReq) String() string { return proto.CompactTextString(m) }
+func (*ListDeletedAdminsReq) ProtoMessage()    {}
+func (*ListDeletedAdminsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{14}
+}
+func (m *ListDeletedAdminsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDeletedAdminsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDeletedAdminsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListDeletedAdminsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeletedAdminsReq.Merge(m, src)
+}
+func (m *ListDeletedAdminsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDeletedAdminsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeletedAdminsReq.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
-	out := new(Admin)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDeletedAdminsReq proto.InternalMessageInfo
+
+func (m *ListDeletedAdminsReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *adminServiceClient) Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
-	out := new(Admin)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListDeletedAdminsReq) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *adminServiceClient) Get(ctx context.Context, in *GetAdminReqById, opts ...grpc.CallOption) (*Admin, error) {
-	out := new(Admin)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListDeletedAdminsReq) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *adminServiceClient) ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error) {
-	out := new(ListAdminsResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/ListAdmins", in, out, opts...)
-	if err != nil {
-		return nil, err
+// before and after hold the field values as text, passwords and refresh tokens are masked
+type AuditChange struct {
+	Before               string   `protobuf:"bytes,1,opt,name=before,proto3" json:"before"`
+	After                string   `protobuf:"bytes,2,opt,name=after,proto3" json:"after"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditChange) Reset()         { *m = AuditChange{} }
+func (m *AuditChange) String() string { return proto.CompactTextString(m) }
+func (*AuditChange) ProtoMessage()    {}
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{15}
+}
+func (m *AuditChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AuditChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditChange.Merge(m, src)
+}
+func (m *AuditChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditChange.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/user.AdminService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AuditChange proto.InternalMessageInfo
+
+func (m *AuditChange) GetBefore() string {
+	if m != nil {
+		return m.Before
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error) {
-	out := new(CheckAdminFieldResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/CheckField", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AuditChange) GetAfter() string {
+	if m != nil {
+		return m.After
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) IfExists(ctx context.Context, in *IfAdminExistsReq, opts ...grpc.CallOption) (*IfAdminExistsResp, error) {
-	out := new(IfAdminExistsResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/IfExists", in, out, opts...)
-	if err != nil {
-		return nil, err
+type AuditEntry struct {
+	Id                   int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	EntityType           string                  `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type"`
+	EntityId             string                  `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id"`
+	Action               string                  `protobuf:"bytes,4,opt,name=action,proto3" json:"action"`
+	ActorId              string                  `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id"`
+	ActorType            string                  `protobuf:"bytes,6,opt,name=actor_type,json=actorType,proto3" json:"actor_type"`
+	RequestId            string                  `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id"`
+	Ip                   string                  `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip"`
+	Changes              map[string]*AuditChange `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt            string                  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{16}
+}
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error) {
-	out := new(ChangeAdminPasswordResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *adminServiceClient) UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error) {
-	out := new(UpdateRefreshTokenAdminResp)
-	err := c.cc.Invoke(ctx, "/user.AdminService/UpdateRefreshToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AuditEntry) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
 	}
-	return out, nil
+	return ""
 }
 
-// AdminServiceServer is the server API for AdminService service.
-type AdminServiceServer interface {
-	Create(context.Context, *Admin) (*Admin, error)
-	Update(context.Context, *Admin) (*Admin, error)
-	Get(context.Context, *GetAdminReqById) (*Admin, error)
-	ListAdmins(context.Context, *ListAdminsReq) (*ListAdminsResp, error)
-	Delete(context.Context, *DeleteAdminReq) (*empty.Empty, error)
-	CheckField(context.Context, *CheckAdminFieldReq) (*CheckAdminFieldResp, error)
-	IfExists(context.Context, *IfAdminExistsReq) (*IfAdminExistsResp, error)
-	ChangePassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
-	UpdateRefreshToken(context.Context, *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error)
+func (m *AuditEntry) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
 }
 
-// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAdminServiceServer struct {
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (*UnimplementedAdminServiceServer) Create(ctx context.Context, req *Admin) (*Admin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (m *AuditEntry) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) Update(ctx context.Context, req *Admin) (*Admin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+
+func (m *AuditEntry) GetActorType() string {
+	if m != nil {
+		return m.ActorType
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) Get(ctx context.Context, req *GetAdminReqById) (*Admin, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (m *AuditEntry) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) ListAdmins(ctx context.Context, req *ListAdminsReq) (*ListAdminsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+
+func (m *AuditEntry) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) Delete(ctx context.Context, req *DeleteAdminReq) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func (m *AuditEntry) GetChanges() map[string]*AuditChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
-func (*UnimplementedAdminServiceServer) CheckField(ctx context.Context, req *CheckAdminFieldReq) (*CheckAdminFieldResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+
+func (m *AuditEntry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
 }
-func (*UnimplementedAdminServiceServer) IfExists(ctx context.Context, req *IfAdminExistsReq) (*IfAdminExistsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IfExists not implemented")
+
+// filter keys: entity_type, entity_id, actor_id, action, created_after and created_before (RFC 3339)
+type ListAuditLogReq struct {
+	Limit                uint64            `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Offset               uint64            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset"`
+	Filter               map[string]string `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (*UnimplementedAdminServiceServer) ChangePassword(ctx context.Context, req *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+
+func (m *ListAuditLogReq) Reset()         { *m = ListAuditLogReq{} }
+func (m *ListAuditLogReq) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogReq) ProtoMessage()    {}
+func (*ListAuditLogReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{17}
 }
-func (*UnimplementedAdminServiceServer) UpdateRefreshToken(ctx context.Context, req *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefreshToken not implemented")
+func (m *ListAuditLogReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditLogReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditLogReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAuditLogReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogReq.Merge(m, src)
+}
+func (m *ListAuditLogReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditLogReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogReq.DiscardUnknown(m)
 }
 
-func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
+var xxx_messageInfo_ListAuditLogReq proto.InternalMessageInfo
+
+func (m *ListAuditLogReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func _AdminService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Admin)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListAuditLogReq) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Create(ctx, in)
+	return 0
+}
+
+func (m *ListAuditLogReq) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Create",
+	return nil
+}
+
+type ListAuditLogResp struct {
+	Entries              []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Count                uint64        `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListAuditLogResp) Reset()         { *m = ListAuditLogResp{} }
+func (m *ListAuditLogResp) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogResp) ProtoMessage()    {}
+func (*ListAuditLogResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{18}
+}
+func (m *ListAuditLogResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAuditLogResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAuditLogResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Create(ctx, req.(*Admin))
+}
+func (m *ListAuditLogResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogResp.Merge(m, src)
+}
+func (m *ListAuditLogResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAuditLogResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditLogResp proto.InternalMessageInfo
+
+func (m *ListAuditLogResp) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AdminService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Admin)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListAuditLogResp) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Update(ctx, in)
+	return 0
+}
+
+// admin is created pending without a password, channel is email (default) or sms
+type InviteAdminReq struct {
+	Admin                *Admin   `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin"`
+	Channel              string   `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteAdminReq) Reset()         { *m = InviteAdminReq{} }
+func (m *InviteAdminReq) String() string { return proto.CompactTextString(m) }
+func (*InviteAdminReq) ProtoMessage()    {}
+func (*InviteAdminReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{19}
+}
+func (m *InviteAdminReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InviteAdminReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InviteAdminReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Update",
+}
+func (m *InviteAdminReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteAdminReq.Merge(m, src)
+}
+func (m *InviteAdminReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *InviteAdminReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteAdminReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteAdminReq proto.InternalMessageInfo
+
+func (m *InviteAdminReq) GetAdmin() *Admin {
+	if m != nil {
+		return m.Admin
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Update(ctx, req.(*Admin))
+	return nil
+}
+
+func (m *InviteAdminReq) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAdminReqById)
-	if err := dec(in); err != nil {
-		return nil, err
+// token is only returned when the notification service did not deliver the invite,
+// the inviter then hands it over to the new admin
+type InviteAdminResp struct {
+	Invite               *AdminInvite `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite"`
+	Notified             bool         `protobuf:"varint,2,opt,name=notified,proto3" json:"notified"`
+	Token                string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *InviteAdminResp) Reset()         { *m = InviteAdminResp{} }
+func (m *InviteAdminResp) String() string { return proto.CompactTextString(m) }
+func (*InviteAdminResp) ProtoMessage()    {}
+func (*InviteAdminResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{20}
+}
+func (m *InviteAdminResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InviteAdminResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InviteAdminResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Get(ctx, in)
+}
+func (m *InviteAdminResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteAdminResp.Merge(m, src)
+}
+func (m *InviteAdminResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *InviteAdminResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteAdminResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteAdminResp proto.InternalMessageInfo
+
+func (m *InviteAdminResp) GetInvite() *AdminInvite {
+	if m != nil {
+		return m.Invite
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Get",
+	return nil
+}
+
+func (m *InviteAdminResp) GetNotified() bool {
+	if m != nil {
+		return m.Notified
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Get(ctx, req.(*GetAdminReqById))
+	return false
+}
+
+func (m *InviteAdminResp) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAdminsReq)
-	if err := dec(in); err != nil {
-		return nil, err
+// status: pending, accepted, revoked or expired
+type AdminInvite struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AdminId              string   `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id"`
+	Channel              string   `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel"`
+	Recipient            string   `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient"`
+	InvitedBy            string   `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by"`
+	Status               string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	ExpiresAt            string   `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at"`
+	AcceptedAt           string   `protobuf:"bytes,8,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at"`
+	RevokedAt            string   `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at"`
+	CreatedAt            string   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdminInvite) Reset()         { *m = AdminInvite{} }
+func (m *AdminInvite) String() string { return proto.CompactTextString(m) }
+func (*AdminInvite) ProtoMessage()    {}
+func (*AdminInvite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{21}
+}
+func (m *AdminInvite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminInvite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminInvite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ListAdmins(ctx, in)
+}
+func (m *AdminInvite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminInvite.Merge(m, src)
+}
+func (m *AdminInvite) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminInvite) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminInvite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminInvite proto.InternalMessageInfo
+
+func (m *AdminInvite) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/ListAdmins",
+	return ""
+}
+
+func (m *AdminInvite) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListAdmins(ctx, req.(*ListAdminsReq))
+	return ""
+}
+
+func (m *AdminInvite) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAdminReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AdminInvite) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).Delete(ctx, in)
+	return ""
+}
+
+func (m *AdminInvite) GetInvitedBy() string {
+	if m != nil {
+		return m.InvitedBy
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/Delete",
+	return ""
+}
+
+func (m *AdminInvite) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).Delete(ctx, req.(*DeleteAdminReq))
+	return ""
+}
+
+func (m *AdminInvite) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckAdminFieldReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).CheckField(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/CheckField",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).CheckField(ctx, req.(*CheckAdminFieldReq))
+func (m *AdminInvite) GetAcceptedAt() string {
+	if m != nil {
+		return m.AcceptedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_IfExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IfAdminExistsReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AdminInvite) GetRevokedAt() string {
+	if m != nil {
+		return m.RevokedAt
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).IfExists(ctx, in)
+	return ""
+}
+
+func (m *AdminInvite) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/IfExists",
+	return ""
+}
+
+type AcceptInviteReq struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptInviteReq) Reset()         { *m = AcceptInviteReq{} }
+func (m *AcceptInviteReq) String() string { return proto.CompactTextString(m) }
+func (*AcceptInviteReq) ProtoMessage()    {}
+func (*AcceptInviteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{22}
+}
+func (m *AcceptInviteReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptInviteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptInviteReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).IfExists(ctx, req.(*IfAdminExistsReq))
+}
+func (m *AcceptInviteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInviteReq.Merge(m, src)
+}
+func (m *AcceptInviteReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptInviteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInviteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInviteReq proto.InternalMessageInfo
+
+func (m *AcceptInviteReq) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _AdminService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeAdminPasswordReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *AcceptInviteReq) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ChangePassword(ctx, in)
+	return ""
+}
+
+// filter keys: admin_id and status
+type ListInvitesReq struct {
+	Limit                uint64            `protobuf:"varint,1,opt,name=limit,proto3" json:"limit"`
+	Offset               uint64            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset"`
+	Filter               map[string]string `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListInvitesReq) Reset()         { *m = ListInvitesReq{} }
+func (m *ListInvitesReq) String() string { return proto.CompactTextString(m) }
+func (*ListInvitesReq) ProtoMessage()    {}
+func (*ListInvitesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{23}
+}
+func (m *ListInvitesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListInvitesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListInvitesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/ChangePassword",
+}
+func (m *ListInvitesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitesReq.Merge(m, src)
+}
+func (m *ListInvitesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListInvitesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitesReq proto.InternalMessageInfo
+
+func (m *ListInvitesReq) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ChangePassword(ctx, req.(*ChangeAdminPasswordReq))
+	return 0
+}
+
+func (m *ListInvitesReq) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AdminService_UpdateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRefreshTokenAdminReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListInvitesReq) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, in)
+	return nil
+}
+
+type ListInvitesResp struct {
+	Invites              []*AdminInvite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites"`
+	Count                uint64         `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListInvitesResp) Reset()         { *m = ListInvitesResp{} }
+func (m *ListInvitesResp) String() string { return proto.CompactTextString(m) }
+func (*ListInvitesResp) ProtoMessage()    {}
+func (*ListInvitesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{24}
+}
+func (m *ListInvitesResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListInvitesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListInvitesResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/user.AdminService/UpdateRefreshToken",
+}
+func (m *ListInvitesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvitesResp.Merge(m, src)
+}
+func (m *ListInvitesResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListInvitesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvitesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListInvitesResp proto.InternalMessageInfo
+
+func (m *ListInvitesResp) GetInvites() []*AdminInvite {
+	if m != nil {
+		return m.Invites
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, req.(*UpdateRefreshTokenAdminReq))
+	return nil
+}
+
+func (m *ListInvitesResp) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _AdminService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "user.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Create",
-			Handler:    _AdminService_Create_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _AdminService_Update_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _AdminService_Get_Handler,
-		},
-		{
-			MethodName: "ListAdmins",
-			Handler:    _AdminService_ListAdmins_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _AdminService_Delete_Handler,
-		},
-		{
-			MethodName: "CheckField",
-			Handler:    _AdminService_CheckField_Handler,
-		},
-		{
-			MethodName: "IfExists",
-			Handler:    _AdminService_IfExists_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _AdminService_ChangePassword_Handler,
-		},
-		{
-			MethodName: "UpdateRefreshToken",
-			Handler:    _AdminService_UpdateRefreshToken_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "user_service/admin.proto",
+// revoking a pending or expired invite removes the pending admin
+type RevokeInviteReq struct {
+	InviteId             string   `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Admin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RevokeInviteReq) Reset()         { *m = RevokeInviteReq{} }
+func (m *RevokeInviteReq) String() string { return proto.CompactTextString(m) }
+func (*RevokeInviteReq) ProtoMessage()    {}
+func (*RevokeInviteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{25}
+}
+func (m *RevokeInviteReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeInviteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeInviteReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RevokeInviteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeInviteReq.Merge(m, src)
+}
+func (m *RevokeInviteReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeInviteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeInviteReq.DiscardUnknown(m)
 }
 
-func (m *Admin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RevokeInviteReq proto.InternalMessageInfo
+
+func (m *RevokeInviteReq) GetInviteId() string {
+	if m != nil {
+		return m.InviteId
+	}
+	return ""
 }
 
-func (m *Admin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// end_work_year defaults to today
+type TerminateAdminReq struct {
+	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id"`
+	EndWorkYear          string   `protobuf:"bytes,2,opt,name=end_work_year,json=endWorkYear,proto3" json:"end_work_year"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminateAdminReq) Reset()         { *m = TerminateAdminReq{} }
+func (m *TerminateAdminReq) String() string { return proto.CompactTextString(m) }
+func (*TerminateAdminReq) ProtoMessage()    {}
+func (*TerminateAdminReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{26}
+}
+func (m *TerminateAdminReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminateAdminReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminateAdminReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DeletedAt) > 0 {
-		i -= len(m.DeletedAt)
-		copy(dAtA[i:], m.DeletedAt)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeletedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.UpdatedAt) > 0 {
-		i -= len(m.UpdatedAt)
-		copy(dAtA[i:], m.UpdatedAt)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.UpdatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
-	}
-	if len(m.CreatedAt) > 0 {
-		i -= len(m.CreatedAt)
-		copy(dAtA[i:], m.CreatedAt)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.WorkYears != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.WorkYears))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.EndWorkYear) > 0 {
-		i -= len(m.EndWorkYear)
-		copy(dAtA[i:], m.EndWorkYear)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EndWorkYear)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.StartWorkYear) > 0 {
-		i -= len(m.StartWorkYear)
-		copy(dAtA[i:], m.StartWorkYear)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.StartWorkYear)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Biography) > 0 {
-		i -= len(m.Biography)
-		copy(dAtA[i:], m.Biography)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Biography)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Salary != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
-		i--
-		dAtA[i] = 0x5d
-	}
-	if len(m.Gender) > 0 {
-		i -= len(m.Gender)
-		copy(dAtA[i:], m.Gender)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Gender)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.BirthDate) > 0 {
-		i -= len(m.BirthDate)
-		copy(dAtA[i:], m.BirthDate)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BirthDate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LastName) > 0 {
-		i -= len(m.LastName)
-		copy(dAtA[i:], m.LastName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LastName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FirstName) > 0 {
-		i -= len(m.FirstName)
-		copy(dAtA[i:], m.FirstName)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.FirstName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AdminOrder != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.AdminOrder))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TerminateAdminReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateAdminReq.Merge(m, src)
+}
+func (m *TerminateAdminReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateAdminReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateAdminReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateAdminReq proto.InternalMessageInfo
+
+func (m *TerminateAdminReq) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IfAdminExistsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TerminateAdminReq) GetEndWorkYear() string {
+	if m != nil {
+		return m.EndWorkYear
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IfAdminExistsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SalaryChange struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	AdminId              string   `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id"`
+	Salary               float32  `protobuf:"fixed32,3,opt,name=salary,proto3" json:"salary"`
+	CreatedAt            string   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IfAdminExistsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0xa
+func (m *SalaryChange) Reset()         { *m = SalaryChange{} }
+func (m *SalaryChange) String() string { return proto.CompactTextString(m) }
+func (*SalaryChange) ProtoMessage()    {}
+func (*SalaryChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{27}
+}
+func (m *SalaryChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SalaryChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SalaryChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SalaryChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SalaryChange.Merge(m, src)
+}
+func (m *SalaryChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SalaryChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SalaryChange.DiscardUnknown(m)
 }
 
-func (m *GetAdminReqById) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SalaryChange proto.InternalMessageInfo
+
+func (m *SalaryChange) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetAdminReqById) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SalaryChange) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
+	}
+	return ""
 }
 
-func (m *GetAdminReqById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.AdminId) > 0 {
-		i -= len(m.AdminId)
-		copy(dAtA[i:], m.AdminId)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
-		i--
-		dAtA[i] = 0xa
+func (m *SalaryChange) GetSalary() float32 {
+	if m != nil {
+		return m.Salary
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ListAdminsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SalaryChange) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListAdminsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListSalaryHistoryReq struct {
+	AdminId              string   `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Filter) > 0 {
-		for k := range m.Filter {
-			v := m.Filter[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+func (m *ListSalaryHistoryReq) Reset()         { *m = ListSalaryHistoryReq{} }
+func (m *ListSalaryHistoryReq) String() string { return proto.CompactTextString(m) }
+func (*ListSalaryHistoryReq) ProtoMessage()    {}
+func (*ListSalaryHistoryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{28}
+}
+func (m *ListSalaryHistoryReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSalaryHistoryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSalaryHistoryReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Offset != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Limit != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ListSalaryHistoryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSalaryHistoryReq.Merge(m, src)
+}
+func (m *ListSalaryHistoryReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSalaryHistoryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSalaryHistoryReq.DiscardUnknown(m)
 }
 
-func (m *ListAdminsResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListSalaryHistoryReq proto.InternalMessageInfo
+
+func (m *ListSalaryHistoryReq) GetAdminId() string {
+	if m != nil {
+		return m.AdminId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListAdminsResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// changes are ordered newest first, the first one is the current salary
+type ListSalaryHistoryResp struct {
+	Changes              []*SalaryChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	Count                uint64          `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ListAdminsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Count != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admins) > 0 {
-		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ListSalaryHistoryResp) Reset()         { *m = ListSalaryHistoryResp{} }
+func (m *ListSalaryHistoryResp) String() string { return proto.CompactTextString(m) }
+func (*ListSalaryHistoryResp) ProtoMessage()    {}
+func (*ListSalaryHistoryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{29}
+}
+func (m *ListSalaryHistoryResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSalaryHistoryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSalaryHistoryResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ChangeAdminPasswordReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListSalaryHistoryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSalaryHistoryResp.Merge(m, src)
 }
-
-func (m *ChangeAdminPasswordReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListSalaryHistoryResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSalaryHistoryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSalaryHistoryResp.DiscardUnknown(m)
 }
 
-func (m *ChangeAdminPasswordReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Email) > 0 {
-		i -= len(m.Email)
-		copy(dAtA[i:], m.Email)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PhoneNumber) > 0 {
-		i -= len(m.PhoneNumber)
-		copy(dAtA[i:], m.PhoneNumber)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ListSalaryHistoryResp proto.InternalMessageInfo
+
+func (m *ListSalaryHistoryResp) GetChanges() []*SalaryChange {
+	if m != nil {
+		return m.Changes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DeleteAdminReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListSalaryHistoryResp) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeleteAdminReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// format is csv (default, with a header row) or ndjson, columns default to all exportable columns,
+// passwords and tokens are never exported, filter takes the keys of ListAdmins
+type ExportAdminsReq struct {
+	Format               string            `protobuf:"bytes,1,opt,name=format,proto3" json:"format"`
+	Columns              []string          `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns"`
+	Filter               map[string]string `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DeleteAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.AdminId) > 0 {
-		i -= len(m.AdminId)
-		copy(dAtA[i:], m.AdminId)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ExportAdminsReq) Reset()         { *m = ExportAdminsReq{} }
+func (m *ExportAdminsReq) String() string { return proto.CompactTextString(m) }
+func (*ExportAdminsReq) ProtoMessage()    {}
+func (*ExportAdminsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{30}
 }
-
-func (m *ChangeAdminPasswordResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExportAdminsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportAdminsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportAdminsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ChangeAdminPasswordResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExportAdminsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAdminsReq.Merge(m, src)
+}
+func (m *ExportAdminsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportAdminsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAdminsReq.DiscardUnknown(m)
 }
 
-func (m *ChangeAdminPasswordResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ExportAdminsReq proto.InternalMessageInfo
+
+func (m *ExportAdminsReq) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ExportAdminsReq) GetColumns() []string {
+	if m != nil {
+		return m.Columns
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *CheckAdminFieldReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExportAdminsReq) GetFilter() map[string]string {
+	if m != nil {
+		return m.Filter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckAdminFieldReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the chunks of an export are consecutive parts of one file
+type ExportAdminsChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckAdminFieldReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Field) > 0 {
-		i -= len(m.Field)
-		copy(dAtA[i:], m.Field)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
+func (m *ExportAdminsChunk) Reset()         { *m = ExportAdminsChunk{} }
+func (m *ExportAdminsChunk) String() string { return proto.CompactTextString(m) }
+func (*ExportAdminsChunk) ProtoMessage()    {}
+func (*ExportAdminsChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{31}
+}
+func (m *ExportAdminsChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportAdminsChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportAdminsChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ExportAdminsChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAdminsChunk.Merge(m, src)
+}
+func (m *ExportAdminsChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportAdminsChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAdminsChunk.DiscardUnknown(m)
 }
 
-func (m *CheckAdminFieldResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExportAdminsChunk proto.InternalMessageInfo
+
+func (m *ExportAdminsChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CheckAdminFieldResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// at most 100 ids, repeated ids are returned once
+type BatchGetAdminsReq struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CheckAdminFieldResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BatchGetAdminsReq) Reset()         { *m = BatchGetAdminsReq{} }
+func (m *BatchGetAdminsReq) String() string { return proto.CompactTextString(m) }
+func (*BatchGetAdminsReq) ProtoMessage()    {}
+func (*BatchGetAdminsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{32}
+}
+func (m *BatchGetAdminsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchGetAdminsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchGetAdminsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchGetAdminsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetAdminsReq.Merge(m, src)
+}
+func (m *BatchGetAdminsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchGetAdminsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetAdminsReq.DiscardUnknown(m)
 }
 
-func (m *IfAdminExistsResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchGetAdminsReq proto.InternalMessageInfo
+
+func (m *BatchGetAdminsReq) GetIds() []string {
+	if m != nil {
+		return m.Ids
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IfAdminExistsResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// admins are in the order of the request without passwords and tokens, missing_ids matched no active admin
+type BatchGetAdminsResp struct {
+	Admins               []*Admin `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins"`
+	MissingIds           []string `protobuf:"bytes,2,rep,name=missing_ids,json=missingIds,proto3" json:"missing_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IfAdminExistsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.IsExists {
-		i--
-		if m.IsExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *BatchGetAdminsResp) Reset()         { *m = BatchGetAdminsResp{} }
+func (m *BatchGetAdminsResp) String() string { return proto.CompactTextString(m) }
+func (*BatchGetAdminsResp) ProtoMessage()    {}
+func (*BatchGetAdminsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc32bb425e570901, []int{33}
+}
+func (m *BatchGetAdminsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchGetAdminsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchGetAdminsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BatchGetAdminsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetAdminsResp.Merge(m, src)
+}
+func (m *BatchGetAdminsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchGetAdminsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetAdminsResp.DiscardUnknown(m)
 }
 
-func (m *UpdateRefreshTokenAdminReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchGetAdminsResp proto.InternalMessageInfo
+
+func (m *BatchGetAdminsResp) GetAdmins() []*Admin {
+	if m != nil {
+		return m.Admins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpdateRefreshTokenAdminReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchGetAdminsResp) GetMissingIds() []string {
+	if m != nil {
+		return m.MissingIds
+	}
+	return nil
 }
 
-func (m *UpdateRefreshTokenAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RefreshToken) > 0 {
-		i -= len(m.RefreshToken)
-		copy(dAtA[i:], m.RefreshToken)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
-		i--
-		dAtA[i] = 0x12
+func init() {
+	proto.RegisterType((*Admin)(nil), "user.Admin")
+	proto.RegisterType((*IfAdminExistsReq)(nil), "user.IfAdminExistsReq")
+	proto.RegisterType((*GetAdminReqById)(nil), "user.GetAdminReqById")
+	proto.RegisterType((*ListAdminsReq)(nil), "user.ListAdminsReq")
+	proto.RegisterMapType((map[string]string)(nil), "user.ListAdminsReq.FilterEntry")
+	proto.RegisterType((*ListAdminsResp)(nil), "user.ListAdminsResp")
+	proto.RegisterType((*ChangeAdminPasswordReq)(nil), "user.ChangeAdminPasswordReq")
+	proto.RegisterType((*DeleteAdminReq)(nil), "user.DeleteAdminReq")
+	proto.RegisterType((*ChangeAdminPasswordResp)(nil), "user.ChangeAdminPasswordResp")
+	proto.RegisterType((*CheckAdminFieldReq)(nil), "user.CheckAdminFieldReq")
+	proto.RegisterType((*CheckAdminFieldResp)(nil), "user.CheckAdminFieldResp")
+	proto.RegisterType((*IfAdminExistsResp)(nil), "user.IfAdminExistsResp")
+	proto.RegisterType((*UpdateRefreshTokenAdminReq)(nil), "user.UpdateRefreshTokenAdminReq")
+	proto.RegisterType((*UpdateRefreshTokenAdminResp)(nil), "user.UpdateRefreshTokenAdminResp")
+	proto.RegisterType((*RestoreAdminReq)(nil), "user.RestoreAdminReq")
+	proto.RegisterType((*ListDeletedAdminsReq)(nil), "user.ListDeletedAdminsReq")
+	proto.RegisterMapType((map[string]string)(nil), "user.ListDeletedAdminsReq.FilterEntry")
+	proto.RegisterType((*AuditChange)(nil), "user.AuditChange")
+	proto.RegisterType((*AuditEntry)(nil), "user.AuditEntry")
+	proto.RegisterMapType((map[string]*AuditChange)(nil), "user.AuditEntry.ChangesEntry")
+	proto.RegisterType((*ListAuditLogReq)(nil), "user.ListAuditLogReq")
+	proto.RegisterMapType((map[string]string)(nil), "user.ListAuditLogReq.FilterEntry")
+	proto.RegisterType((*ListAuditLogResp)(nil), "user.ListAuditLogResp")
+	proto.RegisterType((*InviteAdminReq)(nil), "user.InviteAdminReq")
+	proto.RegisterType((*InviteAdminResp)(nil), "user.InviteAdminResp")
+	proto.RegisterType((*AdminInvite)(nil), "user.AdminInvite")
+	proto.RegisterType((*AcceptInviteReq)(nil), "user.AcceptInviteReq")
+	proto.RegisterType((*ListInvitesReq)(nil), "user.ListInvitesReq")
+	proto.RegisterMapType((map[string]string)(nil), "user.ListInvitesReq.FilterEntry")
+	proto.RegisterType((*ListInvitesResp)(nil), "user.ListInvitesResp")
+	proto.RegisterType((*RevokeInviteReq)(nil), "user.RevokeInviteReq")
+	proto.RegisterType((*TerminateAdminReq)(nil), "user.TerminateAdminReq")
+	proto.RegisterType((*SalaryChange)(nil), "user.SalaryChange")
+	proto.RegisterType((*ListSalaryHistoryReq)(nil), "user.ListSalaryHistoryReq")
+	proto.RegisterType((*ListSalaryHistoryResp)(nil), "user.ListSalaryHistoryResp")
+	proto.RegisterType((*ExportAdminsReq)(nil), "user.ExportAdminsReq")
+	proto.RegisterMapType((map[string]string)(nil), "user.ExportAdminsReq.FilterEntry")
+	proto.RegisterType((*ExportAdminsChunk)(nil), "user.ExportAdminsChunk")
+	proto.RegisterType((*BatchGetAdminsReq)(nil), "user.BatchGetAdminsReq")
+	proto.RegisterType((*BatchGetAdminsResp)(nil), "user.BatchGetAdminsResp")
+}
+
+func init() { proto.RegisterFile("user_service/admin.proto", fileDescriptor_cc32bb425e570901) }
+
+var fileDescriptor_cc32bb425e570901 = []byte{
+	// 1820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
+	0x11, 0x2f, 0x48, 0x89, 0x22, 0x97, 0x94, 0x28, 0x9d, 0x25, 0x19, 0x81, 0x23, 0x9b, 0x46, 0xa6,
+	0x89, 0xd2, 0xb8, 0x74, 0xa2, 0x4e, 0xe3, 0xc4, 0x9e, 0xb6, 0x91, 0x65, 0xc7, 0xe5, 0xc4, 0x4e,
+	0x5b, 0xc4, 0x9d, 0x4e, 0xda, 0x07, 0x0e, 0x44, 0x1c, 0xc5, 0x1b, 0x92, 0x00, 0x7c, 0x77, 0x94,
+	0xcd, 0xd7, 0x7e, 0x8a, 0x7e, 0x81, 0x7e, 0x80, 0x4e, 0xa6, 0x0f, 0x7d, 0xea, 0x6b, 0x1e, 0xfb,
+	0x11, 0x3a, 0xee, 0x17, 0xe9, 0xdc, 0xde, 0x1d, 0x09, 0x80, 0x7f, 0xe2, 0xba, 0x7a, 0xc3, 0xfe,
+	0x76, 0x6f, 0x6f, 0xef, 0xf6, 0xef, 0x01, 0xdc, 0x89, 0xa0, 0xbc, 0x2b, 0x28, 0xbf, 0x64, 0x3d,
+	0x7a, 0x37, 0x8c, 0xc6, 0x2c, 0x6e, 0xa7, 0x3c, 0x91, 0x09, 0xd9, 0x50, 0x1c, 0xef, 0xc6, 0x45,
+	0x92, 0x5c, 0x8c, 0xe8, 0x5d, 0xc4, 0xce, 0x27, 0xfd, 0xbb, 0x74, 0x9c, 0xca, 0xa9, 0x16, 0xf1,
+	0x5a, 0x45, 0x66, 0x9f, 0xd1, 0x51, 0xd4, 0x1d, 0x87, 0x62, 0xa8, 0x25, 0xfc, 0xef, 0x36, 0x61,
+	0xf3, 0x54, 0x29, 0x25, 0x3b, 0x50, 0x62, 0x91, 0xeb, 0xb4, 0x9c, 0xe3, 0x5a, 0x50, 0x62, 0x11,
+	0xb9, 0x05, 0x75, 0xdc, 0xad, 0x9b, 0xf0, 0x88, 0x72, 0xb7, 0xd4, 0x72, 0x8e, 0xcb, 0x01, 0x20,
+	0xf4, 0x1b, 0x85, 0x10, 0x02, 0x1b, 0x3c, 0x19, 0x51, 0xb7, 0x8c, 0x4b, 0xf0, 0x9b, 0x1c, 0x01,
+	0xf4, 0x19, 0x17, 0xb2, 0x1b, 0x87, 0x63, 0xea, 0x6e, 0x20, 0xa7, 0x86, 0xc8, 0xd7, 0xe1, 0x98,
+	0x92, 0x1b, 0x50, 0x1b, 0x85, 0x96, 0xbb, 0x89, 0xdc, 0xea, 0x28, 0x34, 0xcc, 0x23, 0x80, 0x73,
+	0xc6, 0xe5, 0xa0, 0x1b, 0x85, 0x92, 0xba, 0x15, 0xbd, 0x16, 0x91, 0x47, 0xa1, 0xa4, 0xe4, 0x36,
+	0x34, 0xd2, 0x41, 0x12, 0xd3, 0x6e, 0x3c, 0x19, 0x9f, 0x53, 0xee, 0x6e, 0xa1, 0x40, 0x1d, 0xb1,
+	0xaf, 0x11, 0x22, 0xfb, 0xb0, 0x49, 0xc7, 0x21, 0x1b, 0xb9, 0x55, 0xe4, 0x69, 0x82, 0x78, 0x50,
+	0x4d, 0x43, 0x21, 0x5e, 0x26, 0x3c, 0x72, 0x6b, 0x7a, 0x4f, 0x4b, 0x93, 0x43, 0xa8, 0x5c, 0xd0,
+	0x58, 0x9d, 0x0f, 0x90, 0x63, 0x28, 0x85, 0x8b, 0x70, 0x14, 0xf2, 0xa9, 0x5b, 0x6f, 0x39, 0xc7,
+	0xa5, 0xc0, 0x50, 0xe4, 0x5d, 0xa8, 0x9d, 0xb3, 0xe4, 0x82, 0x87, 0xe9, 0x60, 0xea, 0x36, 0xac,
+	0x89, 0x06, 0x20, 0xef, 0x43, 0x53, 0xc8, 0x90, 0xcb, 0xee, 0xcb, 0x84, 0x0f, 0xbb, 0x53, 0x1a,
+	0x72, 0x77, 0x1b, 0x65, 0xb6, 0x11, 0xfe, 0x43, 0xc2, 0x87, 0xdf, 0xd2, 0x90, 0x13, 0x1f, 0xb6,
+	0x69, 0x1c, 0x65, 0xa4, 0x76, 0xf4, 0x59, 0x68, 0x1c, 0xcd, 0x64, 0x8e, 0x00, 0x66, 0x7c, 0xe1,
+	0x36, 0x5b, 0xce, 0xf1, 0x46, 0x50, 0x7b, 0x69, 0xb8, 0x82, 0xbc, 0x07, 0xdb, 0x9c, 0xf6, 0x39,
+	0x15, 0x83, 0xae, 0x4c, 0x86, 0x34, 0x76, 0x77, 0x51, 0x45, 0xc3, 0x80, 0xcf, 0x15, 0xa6, 0x74,
+	0xf4, 0x38, 0x0d, 0x25, 0x8d, 0xba, 0xa1, 0x74, 0xf7, 0xb4, 0xb9, 0x06, 0x39, 0x95, 0x8a, 0x3d,
+	0x49, 0x23, 0xcb, 0x26, 0x9a, 0x6d, 0x10, 0xcd, 0x8e, 0xe8, 0x88, 0x1a, 0xf6, 0x35, 0xcd, 0x36,
+	0xc8, 0xa9, 0x24, 0x2e, 0x6c, 0x5d, 0x52, 0x2e, 0x58, 0x12, 0xbb, 0xfb, 0x68, 0x9d, 0x25, 0xc9,
+	0x03, 0xa8, 0x6b, 0x2d, 0x18, 0x68, 0xee, 0x41, 0xcb, 0x39, 0xae, 0x9f, 0x78, 0x6d, 0x1d, 0x8b,
+	0x6d, 0x1b, 0x8b, 0xed, 0x2f, 0x55, 0x2c, 0x3e, 0x0b, 0xc5, 0x30, 0x30, 0x66, 0xa8, 0x6f, 0x75,
+	0x30, 0x49, 0xf9, 0x98, 0xc5, 0xd6, 0xae, 0x43, 0x7d, 0xb0, 0x39, 0x78, 0x2a, 0xfd, 0xaf, 0x60,
+	0xb7, 0xd3, 0xc7, 0xb0, 0x7d, 0xfc, 0x8a, 0x09, 0x29, 0x02, 0xfa, 0x62, 0x21, 0x3e, 0x9c, 0x35,
+	0xf1, 0x51, 0xca, 0xc4, 0x87, 0x7f, 0x07, 0x9a, 0x4f, 0xa8, 0x44, 0x6d, 0x01, 0x7d, 0xf1, 0x70,
+	0xda, 0x89, 0xc8, 0x3b, 0x50, 0xd5, 0xb1, 0x3f, 0xcb, 0x88, 0x2d, 0xa4, 0x3b, 0x91, 0xff, 0x37,
+	0x07, 0xb6, 0x9f, 0x32, 0xa1, 0xe5, 0x71, 0xe3, 0x7d, 0xd8, 0x1c, 0xb1, 0x31, 0x93, 0x28, 0xb9,
+	0x11, 0x68, 0x42, 0x45, 0x50, 0xd2, 0xef, 0x0b, 0x2a, 0x71, 0xb3, 0x8d, 0xc0, 0x50, 0xe4, 0x1e,
+	0x54, 0xfa, 0x6c, 0x24, 0x29, 0x77, 0xcb, 0xad, 0xf2, 0x71, 0xfd, 0xe4, 0x56, 0x5b, 0xa5, 0x71,
+	0x3b, 0xa7, 0xb2, 0xfd, 0x25, 0x4a, 0x3c, 0x8e, 0x25, 0x9f, 0x06, 0x46, 0xdc, 0xfb, 0x1c, 0xea,
+	0x19, 0x98, 0xec, 0x42, 0x79, 0x48, 0xa7, 0xc6, 0x3a, 0xf5, 0xa9, 0xec, 0xb8, 0x0c, 0x47, 0x13,
+	0x6a, 0x4f, 0x87, 0xc4, 0xfd, 0xd2, 0x67, 0x8e, 0xff, 0x15, 0xec, 0x64, 0xf5, 0x8b, 0x94, 0xbc,
+	0x07, 0x15, 0x3c, 0x90, 0x70, 0x1d, 0xb4, 0xa2, 0xae, 0xad, 0xd0, 0x97, 0x60, 0x58, 0x4a, 0x61,
+	0x2f, 0x99, 0xc4, 0xf6, 0x04, 0x9a, 0xf0, 0xc7, 0x70, 0x78, 0x36, 0x08, 0xe3, 0x0b, 0x8a, 0xc2,
+	0xbf, 0x35, 0x99, 0xf4, 0xff, 0x78, 0x20, 0x97, 0xa1, 0xe5, 0x7c, 0x86, 0xfa, 0x1f, 0xc1, 0xce,
+	0x23, 0x8c, 0x39, 0xeb, 0xa0, 0x75, 0xce, 0xf9, 0x04, 0xae, 0x2f, 0xb5, 0x4d, 0xa4, 0x98, 0xd1,
+	0x32, 0x94, 0x13, 0x81, 0x6b, 0xaa, 0x81, 0xa1, 0xfc, 0x2f, 0x80, 0x9c, 0x0d, 0x68, 0x6f, 0x88,
+	0x2b, 0x30, 0x24, 0x8d, 0x4f, 0xf5, 0x5d, 0x3a, 0x99, 0xbb, 0x54, 0x28, 0x16, 0x50, 0x6b, 0x3d,
+	0x12, 0xfe, 0x4f, 0xe1, 0xda, 0x82, 0x86, 0x35, 0x1b, 0x7e, 0x0c, 0x7b, 0x85, 0xd8, 0x15, 0xa9,
+	0x2a, 0x8c, 0x4c, 0x74, 0x29, 0x02, 0x46, 0xbe, 0xca, 0x84, 0x16, 0xf0, 0x7f, 0x07, 0xde, 0xef,
+	0x31, 0x41, 0x82, 0x4c, 0x72, 0xcf, 0xae, 0xa3, 0x58, 0xb7, 0x17, 0x2a, 0x43, 0x69, 0xb1, 0x32,
+	0xf8, 0x3f, 0x87, 0x1b, 0x2b, 0x55, 0xae, 0xb1, 0xfd, 0x0e, 0x34, 0x03, 0x2a, 0x64, 0xc2, 0xdf,
+	0xc8, 0x1b, 0xff, 0x74, 0x60, 0x5f, 0xc5, 0xdd, 0x23, 0x53, 0x33, 0xde, 0x32, 0x63, 0x7e, 0x59,
+	0xc8, 0x98, 0xf7, 0xe7, 0x19, 0x53, 0xd4, 0x7c, 0xd5, 0x89, 0xf3, 0x00, 0xea, 0xa7, 0x93, 0x88,
+	0x49, 0x1d, 0x54, 0xca, 0xc2, 0x73, 0xda, 0x4f, 0xb8, 0x0d, 0x0b, 0x43, 0x29, 0x05, 0x61, 0x5f,
+	0x9a, 0x26, 0x59, 0x0b, 0x34, 0xe1, 0xff, 0xb9, 0x0c, 0x80, 0xab, 0xf5, 0xbe, 0x73, 0x3f, 0x95,
+	0x6d, 0x7f, 0xa5, 0xb1, 0x64, 0x72, 0xda, 0x95, 0xd3, 0xd4, 0xee, 0x0d, 0x1a, 0x7a, 0x3e, 0x4d,
+	0xb1, 0x59, 0x1a, 0x01, 0x36, 0x4b, 0x0b, 0x0d, 0x74, 0xb0, 0x71, 0x85, 0x3d, 0xa9, 0x8a, 0xaf,
+	0x6e, 0xb2, 0x86, 0x42, 0x77, 0xf4, 0x64, 0xc2, 0xd5, 0x9a, 0x4d, 0xe3, 0x0e, 0x45, 0x77, 0x22,
+	0x55, 0xcf, 0x35, 0x0b, 0xf7, 0x33, 0xfd, 0x15, 0x11, 0xdc, 0xee, 0x08, 0x80, 0xd3, 0x17, 0x13,
+	0x2a, 0xa4, 0x5a, 0xab, 0xbb, 0x6b, 0xcd, 0x20, 0x9d, 0x08, 0xcd, 0x4f, 0x4d, 0x63, 0x2d, 0xb1,
+	0x94, 0xdc, 0x83, 0xad, 0x1e, 0xde, 0x8a, 0x70, 0x6b, 0xe8, 0x96, 0x23, 0x53, 0x42, 0x66, 0x27,
+	0x6e, 0xeb, 0x5b, 0x13, 0x48, 0x04, 0x56, 0xba, 0xd0, 0x94, 0xa0, 0xd0, 0x94, 0xbc, 0x67, 0xd0,
+	0xc8, 0xae, 0x5b, 0xe2, 0xae, 0x0f, 0xb2, 0xee, 0xaa, 0x9f, 0xec, 0x65, 0xf6, 0xd5, 0x2b, 0xb3,
+	0x1e, 0xfc, 0xbb, 0x03, 0x4d, 0xac, 0x7d, 0x8a, 0xfd, 0x34, 0xb9, 0xf8, 0xdf, 0xc3, 0xef, 0xf3,
+	0x42, 0xf8, 0xdd, 0xce, 0x14, 0xec, 0xb9, 0xd2, 0xab, 0x8e, 0xbc, 0xe7, 0xb0, 0x9b, 0xdf, 0x41,
+	0xa4, 0xe4, 0x27, 0xb0, 0x45, 0x63, 0xc9, 0x19, 0xb5, 0x55, 0x7b, 0xb7, 0x78, 0xe5, 0x81, 0x15,
+	0x58, 0x51, 0xbb, 0x9f, 0xc1, 0x4e, 0x27, 0xbe, 0x64, 0x99, 0x62, 0x7a, 0x1b, 0x36, 0x31, 0x5d,
+	0xd1, 0xaa, 0x42, 0x1f, 0xd0, 0x1c, 0xd5, 0xe8, 0x95, 0xef, 0x62, 0x6a, 0xab, 0xb6, 0x25, 0xfd,
+	0x18, 0x9a, 0x39, 0x75, 0x22, 0x25, 0x1f, 0x42, 0x85, 0x21, 0xe4, 0x3a, 0x39, 0xef, 0x60, 0x49,
+	0x40, 0x46, 0x60, 0x04, 0x54, 0xd5, 0x8f, 0x13, 0xc9, 0xfa, 0x8c, 0xea, 0x82, 0x5a, 0x0d, 0x66,
+	0xb4, 0x32, 0x5f, 0x17, 0x2f, 0x1d, 0xf7, 0x9a, 0xf0, 0xff, 0x5a, 0x82, 0x7a, 0x46, 0xd3, 0x42,
+	0xe9, 0xcb, 0xd6, 0xa2, 0x52, 0xae, 0x16, 0x65, 0x0f, 0x51, 0xce, 0x1d, 0x42, 0x8d, 0x74, 0x9c,
+	0xf6, 0x58, 0xca, 0x68, 0x2c, 0xed, 0xc4, 0x3a, 0x03, 0x54, 0xb4, 0x6a, 0x73, 0xa3, 0xee, 0xf9,
+	0xd4, 0x64, 0x54, 0xcd, 0x20, 0x0f, 0xa7, 0x99, 0x42, 0xa9, 0xf3, 0xc9, 0x50, 0x6a, 0x19, 0x7d,
+	0x95, 0x32, 0x4e, 0x85, 0x0a, 0x72, 0x93, 0x4c, 0x06, 0x39, 0x95, 0x38, 0x5b, 0xf7, 0x7a, 0x34,
+	0x35, 0x49, 0xa0, 0xb3, 0x0a, 0x2c, 0xa4, 0x67, 0x2f, 0x4e, 0x2f, 0x93, 0xa1, 0xe6, 0xd7, 0xac,
+	0x55, 0x88, 0x68, 0xf6, 0x9a, 0x1c, 0xf2, 0xcf, 0xa0, 0x79, 0x8a, 0xba, 0xcc, 0x8d, 0xeb, 0x98,
+	0xd7, 0x17, 0xea, 0x64, 0x2e, 0x34, 0xd7, 0x78, 0x4b, 0x85, 0xc6, 0xfb, 0x9d, 0xa3, 0xa7, 0x06,
+	0xad, 0xe3, 0x2d, 0xea, 0xf6, 0x67, 0x85, 0xc4, 0x69, 0xcd, 0x13, 0x67, 0xae, 0xf3, 0xea, 0xf3,
+	0xa6, 0x99, 0xdb, 0x40, 0xa4, 0xe4, 0x23, 0xd8, 0xd2, 0x0e, 0xb3, 0x69, 0xb3, 0x24, 0x26, 0xad,
+	0xc4, 0x8a, 0xbc, 0x69, 0xab, 0xbe, 0xa7, 0x2e, 0x7f, 0x7e, 0xa1, 0xaa, 0x63, 0x23, 0x31, 0x6f,
+	0x7c, 0x55, 0x0d, 0x74, 0x22, 0x3f, 0x80, 0xbd, 0xe7, 0x76, 0x5e, 0x7d, 0x83, 0x4e, 0xb9, 0xf8,
+	0x20, 0x28, 0x2d, 0x3c, 0x08, 0xfc, 0x14, 0x1a, 0xdf, 0xe0, 0x23, 0xc4, 0x34, 0xa3, 0x62, 0x3f,
+	0x59, 0x13, 0xfc, 0xf3, 0xd7, 0x4c, 0x39, 0xf7, 0x9a, 0xc9, 0x87, 0xd1, 0x46, 0x31, 0x8c, 0x3e,
+	0xd1, 0xed, 0x5b, 0xef, 0xfa, 0x6b, 0xa6, 0xfa, 0xfe, 0xf4, 0x07, 0x5a, 0xfe, 0x9f, 0xe0, 0x60,
+	0xc9, 0x12, 0x91, 0x92, 0x3b, 0xf3, 0x76, 0xa1, 0x9d, 0x40, 0xb4, 0x13, 0xb2, 0x47, 0x9a, 0xf7,
+	0x88, 0xe5, 0x5e, 0xf8, 0x87, 0x03, 0xcd, 0xc7, 0xaf, 0xd2, 0x84, 0x67, 0x86, 0xef, 0x43, 0xa8,
+	0xf4, 0x13, 0x3e, 0x0e, 0xa5, 0x6d, 0xc9, 0x9a, 0xc2, 0x7c, 0x4f, 0x46, 0x93, 0x71, 0x2c, 0xdc,
+	0x52, 0xab, 0x8c, 0xf9, 0xae, 0xc9, 0x55, 0xf5, 0xbc, 0xa0, 0xf8, 0xaa, 0xe3, 0xf2, 0x03, 0xd8,
+	0xcb, 0xee, 0x70, 0x36, 0x98, 0xc4, 0x43, 0xf5, 0x82, 0x8e, 0x42, 0x19, 0xa2, 0x86, 0x46, 0x80,
+	0xdf, 0xfe, 0x8f, 0x61, 0xef, 0x61, 0x28, 0x7b, 0x83, 0x27, 0x74, 0x6e, 0x8c, 0xda, 0x89, 0x45,
+	0xfa, 0xe6, 0x6a, 0x81, 0xfa, 0xf4, 0xff, 0x08, 0xa4, 0x28, 0xf6, 0xa6, 0x63, 0xfd, 0x2d, 0xa8,
+	0x8f, 0x99, 0x10, 0x2c, 0xbe, 0xe8, 0xb2, 0xc8, 0x5e, 0x0f, 0x18, 0xa8, 0x13, 0x89, 0x93, 0xef,
+	0x6b, 0xd0, 0xc0, 0x25, 0xdf, 0xe8, 0xbf, 0x0e, 0xc4, 0x87, 0xca, 0x19, 0x46, 0x05, 0xc9, 0x2a,
+	0xf4, 0xb2, 0x84, 0x92, 0xd1, 0x13, 0xe5, 0x1a, 0x99, 0x0f, 0xa1, 0xfc, 0x84, 0x4a, 0x72, 0xa0,
+	0xb1, 0xc2, 0xa3, 0x2b, 0x2f, 0x7a, 0x0f, 0x60, 0xfe, 0x64, 0x21, 0xd7, 0x96, 0x3c, 0x92, 0xbc,
+	0xfd, 0x45, 0x50, 0xa4, 0xe4, 0x53, 0xa8, 0xe8, 0xa9, 0x90, 0x18, 0x7e, 0xfe, 0xf5, 0xe0, 0x1d,
+	0x2e, 0xbc, 0x43, 0x1f, 0xab, 0x1f, 0x26, 0xe4, 0x14, 0x00, 0xa7, 0x78, 0x1c, 0xe0, 0x89, 0xab,
+	0xd7, 0x2e, 0xbe, 0x0c, 0xbc, 0x77, 0x56, 0x70, 0x44, 0x4a, 0x1e, 0x40, 0xb5, 0xd3, 0xd7, 0x33,
+	0x3b, 0x39, 0xd4, 0x62, 0xc5, 0x57, 0xaa, 0x77, 0x7d, 0x29, 0x2e, 0x52, 0xf2, 0x0c, 0x76, 0x74,
+	0x16, 0xd8, 0x57, 0x0b, 0x79, 0xd7, 0xee, 0xb4, 0xec, 0xb1, 0xe5, 0x1d, 0xad, 0xe1, 0x8a, 0x94,
+	0x7c, 0x0b, 0x64, 0x71, 0xc0, 0x27, 0xa6, 0x04, 0xaf, 0x7e, 0x4d, 0x78, 0xb7, 0x7f, 0x40, 0x42,
+	0xa4, 0xe4, 0x04, 0x1a, 0xd9, 0x47, 0x80, 0x75, 0x67, 0xe1, 0x61, 0x90, 0x77, 0xe7, 0xaf, 0xa0,
+	0x9e, 0x99, 0xd7, 0x89, 0xb7, 0x7a, 0x84, 0x5f, 0xe1, 0xd6, 0x5f, 0x40, 0x23, 0x3b, 0x0f, 0xd9,
+	0x4d, 0x0b, 0x53, 0x98, 0x77, 0xb8, 0x0c, 0x16, 0x29, 0xb9, 0x0f, 0xf5, 0xcc, 0xa4, 0x62, 0x43,
+	0x23, 0x3f, 0x0b, 0x79, 0x07, 0x4b, 0x50, 0x7d, 0xde, 0x6c, 0x37, 0xb5, 0x5b, 0x17, 0x3a, 0x6c,
+	0xfe, 0xbc, 0xf7, 0xf5, 0x79, 0x3b, 0xb6, 0xab, 0x2c, 0x6b, 0x7d, 0xde, 0xc1, 0x12, 0x54, 0x1f,
+	0x35, 0xdb, 0x6c, 0xe6, 0xf7, 0x9b, 0x6b, 0x40, 0x2b, 0x03, 0xf9, 0x53, 0xd8, 0xc9, 0xf7, 0x1e,
+	0x62, 0x62, 0x6e, 0xa1, 0x23, 0xe5, 0x4d, 0x7e, 0x0a, 0x7b, 0x0b, 0xa5, 0x3b, 0xeb, 0xa8, 0x62,
+	0x1b, 0xf0, 0x6e, 0xac, 0xe4, 0x89, 0x94, 0x7c, 0x01, 0x8d, 0x6c, 0xbd, 0xb3, 0x87, 0x28, 0x54,
+	0x59, 0xef, 0xfa, 0x22, 0x8c, 0xa5, 0xf1, 0x63, 0x87, 0x9c, 0xc1, 0x4e, 0xbe, 0xc2, 0xd9, 0x73,
+	0x2c, 0x94, 0x47, 0xcf, 0x5d, 0xce, 0x10, 0xe9, 0xc3, 0xdd, 0xef, 0x5f, 0xdf, 0x74, 0xfe, 0xf5,
+	0xfa, 0xa6, 0xf3, 0xef, 0xd7, 0x37, 0x9d, 0xbf, 0xfc, 0xe7, 0xe6, 0x8f, 0xce, 0x2b, 0x78, 0x5d,
+	0x3f, 0xfb, 0xef, 0x00, 0x6f, 0x71, 0xcb, 0x9b, 0x58, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
+	Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error)
+	Get(ctx context.Context, in *GetAdminReqById, opts ...grpc.CallOption) (*Admin, error)
+	ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error)
+	Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error)
+	IfExists(ctx context.Context, in *IfAdminExistsReq, opts ...grpc.CallOption) (*IfAdminExistsResp, error)
+	ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
+	UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error)
+	RestoreAdmin(ctx context.Context, in *RestoreAdminReq, opts ...grpc.CallOption) (*Admin, error)
+	ListDeleted(ctx context.Context, in *ListDeletedAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error)
+	ListAuditLog(ctx context.Context, in *ListAuditLogReq, opts ...grpc.CallOption) (*ListAuditLogResp, error)
+	InviteAdmin(ctx context.Context, in *InviteAdminReq, opts ...grpc.CallOption) (*InviteAdminResp, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteReq, opts ...grpc.CallOption) (*Admin, error)
+	ListInvites(ctx context.Context, in *ListInvitesReq, opts ...grpc.CallOption) (*ListInvitesResp, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteReq, opts ...grpc.CallOption) (*empty.Empty, error)
+	TerminateAdmin(ctx context.Context, in *TerminateAdminReq, opts ...grpc.CallOption) (*Admin, error)
+	ListSalaryHistory(ctx context.Context, in *ListSalaryHistoryReq, opts ...grpc.CallOption) (*ListSalaryHistoryResp, error)
+	ExportAdmins(ctx context.Context, in *ExportAdminsReq, opts ...grpc.CallOption) (AdminService_ExportAdminsClient, error)
+	BatchGetAdmins(ctx context.Context, in *BatchGetAdminsReq, opts ...grpc.CallOption) (*BatchGetAdminsResp, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) Create(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *adminServiceClient) Update(ctx context.Context, in *Admin, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UpdateRefreshTokenAdminResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) Get(ctx context.Context, in *GetAdminReqById, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Get", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UpdateRefreshTokenAdminResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *adminServiceClient) ListAdmins(ctx context.Context, in *ListAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error) {
+	out := new(ListAdminsResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ListAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UpdateRefreshTokenAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *adminServiceClient) Delete(ctx context.Context, in *DeleteAdminReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/user.AdminService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *adminServiceClient) CheckField(ctx context.Context, in *CheckAdminFieldReq, opts ...grpc.CallOption) (*CheckAdminFieldResp, error) {
+	out := new(CheckAdminFieldResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/CheckField", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *Admin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.AdminOrder != 0 {
-		n += 1 + sovAdmin(uint64(m.AdminOrder))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.FirstName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.LastName)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.BirthDate)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Gender)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.Salary != 0 {
-		n += 5
-	}
-	l = len(m.Biography)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.StartWorkYear)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.EndWorkYear)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	if m.WorkYears != 0 {
-		n += 1 + sovAdmin(uint64(m.WorkYears))
-	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.CreatedAt)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.UpdatedAt)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.DeletedAt)
-	if l > 0 {
-		n += 2 + l + sovAdmin(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (c *adminServiceClient) IfExists(ctx context.Context, in *IfAdminExistsReq, opts ...grpc.CallOption) (*IfAdminExistsResp, error) {
+	out := new(IfAdminExistsResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/IfExists", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *IfAdminExistsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+func (c *adminServiceClient) ChangePassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error) {
+	out := new(ChangeAdminPasswordResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateRefreshToken(ctx context.Context, in *UpdateRefreshTokenAdminReq, opts ...grpc.CallOption) (*UpdateRefreshTokenAdminResp, error) {
+	out := new(UpdateRefreshTokenAdminResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/UpdateRefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreAdmin(ctx context.Context, in *RestoreAdminReq, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/RestoreAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetAdminReqById) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) ListDeleted(ctx context.Context, in *ListDeletedAdminsReq, opts ...grpc.CallOption) (*ListAdminsResp, error) {
+	out := new(ListAdminsResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ListDeleted", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AdminId)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogReq, opts ...grpc.CallOption) (*ListAuditLogResp, error) {
+	out := new(ListAuditLogResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ListAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) InviteAdmin(ctx context.Context, in *InviteAdminReq, opts ...grpc.CallOption) (*InviteAdminResp, error) {
+	out := new(InviteAdminResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/InviteAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ListAdminsReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) AcceptInvite(ctx context.Context, in *AcceptInviteReq, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovAdmin(uint64(m.Limit))
+	return out, nil
+}
+
+func (c *adminServiceClient) ListInvites(ctx context.Context, in *ListInvitesReq, opts ...grpc.CallOption) (*ListInvitesResp, error) {
+	out := new(ListInvitesResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ListInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Offset != 0 {
-		n += 1 + sovAdmin(uint64(m.Offset))
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/user.AdminService/RevokeInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Filter) > 0 {
-		for k, v := range m.Filter {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *adminServiceClient) TerminateAdmin(ctx context.Context, in *TerminateAdminReq, opts ...grpc.CallOption) (*Admin, error) {
+	out := new(Admin)
+	err := c.cc.Invoke(ctx, "/user.AdminService/TerminateAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSalaryHistory(ctx context.Context, in *ListSalaryHistoryReq, opts ...grpc.CallOption) (*ListSalaryHistoryResp, error) {
+	out := new(ListSalaryHistoryResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/ListSalaryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ListAdminsResp) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) ExportAdmins(ctx context.Context, in *ExportAdminsReq, opts ...grpc.CallOption) (AdminService_ExportAdminsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/user.AdminService/ExportAdmins", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Admins) > 0 {
-		for _, e := range m.Admins {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+	x := &adminServiceExportAdminsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		n += 1 + sovAdmin(uint64(m.Count))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return x, nil
+}
+
+type AdminService_ExportAdminsClient interface {
+	Recv() (*ExportAdminsChunk, error)
+	grpc.ClientStream
+}
+
+type adminServiceExportAdminsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceExportAdminsClient) Recv() (*ExportAdminsChunk, error) {
+	m := new(ExportAdminsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *ChangeAdminPasswordReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminServiceClient) BatchGetAdmins(ctx context.Context, in *BatchGetAdminsReq, opts ...grpc.CallOption) (*BatchGetAdminsResp, error) {
+	out := new(BatchGetAdminsResp)
+	err := c.cc.Invoke(ctx, "/user.AdminService/BatchGetAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PhoneNumber)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	Create(context.Context, *Admin) (*Admin, error)
+	Update(context.Context, *Admin) (*Admin, error)
+	Get(context.Context, *GetAdminReqById) (*Admin, error)
+	ListAdmins(context.Context, *ListAdminsReq) (*ListAdminsResp, error)
+	Delete(context.Context, *DeleteAdminReq) (*empty.Empty, error)
+	CheckField(context.Context, *CheckAdminFieldReq) (*CheckAdminFieldResp, error)
+	IfExists(context.Context, *IfAdminExistsReq) (*IfAdminExistsResp, error)
+	ChangePassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
+	UpdateRefreshToken(context.Context, *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error)
+	RestoreAdmin(context.Context, *RestoreAdminReq) (*Admin, error)
+	ListDeleted(context.Context, *ListDeletedAdminsReq) (*ListAdminsResp, error)
+	ListAuditLog(context.Context, *ListAuditLogReq) (*ListAuditLogResp, error)
+	InviteAdmin(context.Context, *InviteAdminReq) (*InviteAdminResp, error)
+	AcceptInvite(context.Context, *AcceptInviteReq) (*Admin, error)
+	ListInvites(context.Context, *ListInvitesReq) (*ListInvitesResp, error)
+	RevokeInvite(context.Context, *RevokeInviteReq) (*empty.Empty, error)
+	TerminateAdmin(context.Context, *TerminateAdminReq) (*Admin, error)
+	ListSalaryHistory(context.Context, *ListSalaryHistoryReq) (*ListSalaryHistoryResp, error)
+	ExportAdmins(*ExportAdminsReq, AdminService_ExportAdminsServer) error
+	BatchGetAdmins(context.Context, *BatchGetAdminsReq) (*BatchGetAdminsResp, error)
+}
+
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (*UnimplementedAdminServiceServer) Create(ctx context.Context, req *Admin) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedAdminServiceServer) Update(ctx context.Context, req *Admin) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedAdminServiceServer) Get(ctx context.Context, req *GetAdminReqById) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListAdmins(ctx context.Context, req *ListAdminsReq) (*ListAdminsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (*UnimplementedAdminServiceServer) Delete(ctx context.Context, req *DeleteAdminReq) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedAdminServiceServer) CheckField(ctx context.Context, req *CheckAdminFieldReq) (*CheckAdminFieldResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckField not implemented")
+}
+func (*UnimplementedAdminServiceServer) IfExists(ctx context.Context, req *IfAdminExistsReq) (*IfAdminExistsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IfExists not implemented")
+}
+func (*UnimplementedAdminServiceServer) ChangePassword(ctx context.Context, req *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateRefreshToken(ctx context.Context, req *UpdateRefreshTokenAdminReq) (*UpdateRefreshTokenAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRefreshToken not implemented")
+}
+func (*UnimplementedAdminServiceServer) RestoreAdmin(ctx context.Context, req *RestoreAdminReq) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAdmin not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListDeleted(ctx context.Context, req *ListDeletedAdminsReq) (*ListAdminsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListAuditLog(ctx context.Context, req *ListAuditLogReq) (*ListAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (*UnimplementedAdminServiceServer) InviteAdmin(ctx context.Context, req *InviteAdminReq) (*InviteAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteAdmin not implemented")
+}
+func (*UnimplementedAdminServiceServer) AcceptInvite(ctx context.Context, req *AcceptInviteReq) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListInvites(ctx context.Context, req *ListInvitesReq) (*ListInvitesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (*UnimplementedAdminServiceServer) RevokeInvite(ctx context.Context, req *RevokeInviteReq) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
+func (*UnimplementedAdminServiceServer) TerminateAdmin(ctx context.Context, req *TerminateAdminReq) (*Admin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateAdmin not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListSalaryHistory(ctx context.Context, req *ListSalaryHistoryReq) (*ListSalaryHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSalaryHistory not implemented")
+}
+func (*UnimplementedAdminServiceServer) ExportAdmins(req *ExportAdminsReq, srv AdminService_ExportAdminsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAdmins not implemented")
+}
+func (*UnimplementedAdminServiceServer) BatchGetAdmins(ctx context.Context, req *BatchGetAdminsReq) (*BatchGetAdminsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAdmins not implemented")
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Admin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Email)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Create(ctx, in)
 	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Create",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Create(ctx, req.(*Admin))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeleteAdminReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _AdminService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Admin)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AdminId)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Update(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Update",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Update(ctx, req.(*Admin))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChangeAdminPasswordResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _AdminService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminReqById)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Get(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Get",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Get(ctx, req.(*GetAdminReqById))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckAdminFieldReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _AdminService_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAdmins(ctx, in)
 	}
-	l = len(m.Field)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ListAdmins",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAdmins(ctx, req.(*ListAdminsReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CheckAdminFieldResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _AdminService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Delete(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/Delete",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Delete(ctx, req.(*DeleteAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IfAdminExistsResp) Size() (n int) {
-	if m == nil {
-		return 0
+func _AdminService_CheckField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminFieldReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.IsExists {
-		n += 2
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CheckField(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/CheckField",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CheckField(ctx, req.(*CheckAdminFieldReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRefreshTokenAdminReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _AdminService_IfExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IfAdminExistsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServiceServer).IfExists(ctx, in)
 	}
-	l = len(m.RefreshToken)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/IfExists",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).IfExists(ctx, req.(*IfAdminExistsReq))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateRefreshTokenAdminResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+func _AdminService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAdminPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ChangePassword(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ChangePassword(ctx, req.(*ChangeAdminPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _AdminService_UpdateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRefreshTokenAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/UpdateRefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateRefreshToken(ctx, req.(*UpdateRefreshTokenAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _AdminService_RestoreAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RestoreAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/RestoreAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RestoreAdmin(ctx, req.(*RestoreAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *Admin) Unmarshal(dAtA []byte) error {
+
+func _AdminService_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedAdminsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ListDeleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeleted(ctx, req.(*ListDeletedAdminsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ListAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditLog(ctx, req.(*ListAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_InviteAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).InviteAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/InviteAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).InviteAdmin(ctx, req.(*InviteAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AcceptInvite(ctx, req.(*AcceptInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListInvites(ctx, req.(*ListInvitesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeInvite(ctx, req.(*RevokeInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TerminateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TerminateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/TerminateAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TerminateAdmin(ctx, req.(*TerminateAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSalaryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSalaryHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSalaryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/ListSalaryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSalaryHistory(ctx, req.(*ListSalaryHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportAdmins_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAdminsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ExportAdmins(m, &adminServiceExportAdminsServer{stream})
+}
+
+type AdminService_ExportAdminsServer interface {
+	Send(*ExportAdminsChunk) error
+	grpc.ServerStream
+}
+
+type adminServiceExportAdminsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceExportAdminsServer) Send(m *ExportAdminsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_BatchGetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAdminsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BatchGetAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.AdminService/BatchGetAdmins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BatchGetAdmins(ctx, req.(*BatchGetAdminsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "user.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _AdminService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AdminService_Update_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AdminService_Get_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _AdminService_ListAdmins_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AdminService_Delete_Handler,
+		},
+		{
+			MethodName: "CheckField",
+			Handler:    _AdminService_CheckField_Handler,
+		},
+		{
+			MethodName: "IfExists",
+			Handler:    _AdminService_IfExists_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AdminService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "UpdateRefreshToken",
+			Handler:    _AdminService_UpdateRefreshToken_Handler,
+		},
+		{
+			MethodName: "RestoreAdmin",
+			Handler:    _AdminService_RestoreAdmin_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _AdminService_ListDeleted_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _AdminService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "InviteAdmin",
+			Handler:    _AdminService_InviteAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _AdminService_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _AdminService_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _AdminService_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "TerminateAdmin",
+			Handler:    _AdminService_TerminateAdmin_Handler,
+		},
+		{
+			MethodName: "ListSalaryHistory",
+			Handler:    _AdminService_ListSalaryHistory_Handler,
+		},
+		{
+			MethodName: "BatchGetAdmins",
+			Handler:    _AdminService_BatchGetAdmins_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAdmins",
+			Handler:       _AdminService_ExportAdmins_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "user_service/admin.proto",
+}
+
+func (m *Admin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Admin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Admin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TerminatedAt) > 0 {
+		i -= len(m.TerminatedAt)
+		copy(dAtA[i:], m.TerminatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.TerminatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Version != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.DeletedAt) > 0 {
+		i -= len(m.DeletedAt)
+		copy(dAtA[i:], m.DeletedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeletedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.UpdatedAt) > 0 {
+		i -= len(m.UpdatedAt)
+		copy(dAtA[i:], m.UpdatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.UpdatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.WorkYears != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.WorkYears))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.EndWorkYear) > 0 {
+		i -= len(m.EndWorkYear)
+		copy(dAtA[i:], m.EndWorkYear)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EndWorkYear)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.StartWorkYear) > 0 {
+		i -= len(m.StartWorkYear)
+		copy(dAtA[i:], m.StartWorkYear)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.StartWorkYear)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Biography) > 0 {
+		i -= len(m.Biography)
+		copy(dAtA[i:], m.Biography)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Biography)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Salary != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if len(m.Gender) > 0 {
+		i -= len(m.Gender)
+		copy(dAtA[i:], m.Gender)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Gender)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BirthDate) > 0 {
+		i -= len(m.BirthDate)
+		copy(dAtA[i:], m.BirthDate)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BirthDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastName) > 0 {
+		i -= len(m.LastName)
+		copy(dAtA[i:], m.LastName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.LastName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstName) > 0 {
+		i -= len(m.FirstName)
+		copy(dAtA[i:], m.FirstName)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.FirstName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AdminOrder != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.AdminOrder))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IfAdminExistsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IfAdminExistsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IfAdminExistsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAdminReqById) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAdminReqById) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAdminReqById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAdminsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAdminsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filter) > 0 {
+		for k := range m.Filter {
+			v := m.Filter[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAdminsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAdminsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAdminsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeAdminPasswordReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeAdminPasswordReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeAdminPasswordReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Email) > 0 {
+		i -= len(m.Email)
+		copy(dAtA[i:], m.Email)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Email)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PhoneNumber) > 0 {
+		i -= len(m.PhoneNumber)
+		copy(dAtA[i:], m.PhoneNumber)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.PhoneNumber)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeAdminPasswordResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeAdminPasswordResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeAdminPasswordResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAdminFieldReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAdminFieldReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAdminFieldReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckAdminFieldResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAdminFieldResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAdminFieldResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IfAdminExistsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IfAdminExistsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IfAdminExistsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsExists {
+		i--
+		if m.IsExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRefreshTokenAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRefreshTokenAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRefreshTokenAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RefreshToken) > 0 {
+		i -= len(m.RefreshToken)
+		copy(dAtA[i:], m.RefreshToken)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RefreshToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRefreshTokenAdminResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRefreshTokenAdminResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRefreshTokenAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDeletedAdminsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDeletedAdminsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDeletedAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filter) > 0 {
+		for k := range m.Filter {
+			v := m.Filter[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Before) > 0 {
+		i -= len(m.Before)
+		copy(dAtA[i:], m.Before)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Before)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Changes) > 0 {
+		for k := range m.Changes {
+			v := m.Changes[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintAdmin(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Ip) > 0 {
+		i -= len(m.Ip)
+		copy(dAtA[i:], m.Ip)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Ip)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ActorType) > 0 {
+		i -= len(m.ActorType)
+		copy(dAtA[i:], m.ActorType)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ActorType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EntityId) > 0 {
+		i -= len(m.EntityId)
+		copy(dAtA[i:], m.EntityId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EntityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EntityType) > 0 {
+		i -= len(m.EntityType)
+		copy(dAtA[i:], m.EntityType)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EntityType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditLogReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditLogReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditLogReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filter) > 0 {
+		for k := range m.Filter {
+			v := m.Filter[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAuditLogResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAuditLogResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAuditLogResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InviteAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InviteAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InviteAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Admin != nil {
+		{
+			size, err := m.Admin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InviteAdminResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InviteAdminResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InviteAdminResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Notified {
+		i--
+		if m.Notified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Invite != nil {
+		{
+			size, err := m.Invite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminInvite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminInvite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminInvite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RevokedAt) > 0 {
+		i -= len(m.RevokedAt)
+		copy(dAtA[i:], m.RevokedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RevokedAt)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AcceptedAt) > 0 {
+		i -= len(m.AcceptedAt)
+		copy(dAtA[i:], m.AcceptedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AcceptedAt)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InvitedBy) > 0 {
+		i -= len(m.InvitedBy)
+		copy(dAtA[i:], m.InvitedBy)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.InvitedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptInviteReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptInviteReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptInviteReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListInvitesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListInvitesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListInvitesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filter) > 0 {
+		for k := range m.Filter {
+			v := m.Filter[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Offset != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListInvitesResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListInvitesResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListInvitesResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Invites) > 0 {
+		for iNdEx := len(m.Invites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeInviteReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeInviteReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeInviteReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.InviteId) > 0 {
+		i -= len(m.InviteId)
+		copy(dAtA[i:], m.InviteId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.InviteId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TerminateAdminReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateAdminReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TerminateAdminReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EndWorkYear) > 0 {
+		i -= len(m.EndWorkYear)
+		copy(dAtA[i:], m.EndWorkYear)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.EndWorkYear)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SalaryChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SalaryChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SalaryChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CreatedAt) > 0 {
+		i -= len(m.CreatedAt)
+		copy(dAtA[i:], m.CreatedAt)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.CreatedAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Salary != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Salary))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSalaryHistoryReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSalaryHistoryReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSalaryHistoryReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AdminId) > 0 {
+		i -= len(m.AdminId)
+		copy(dAtA[i:], m.AdminId)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.AdminId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSalaryHistoryResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSalaryHistoryResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSalaryHistoryResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportAdminsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportAdminsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filter) > 0 {
+		for k := range m.Filter {
+			v := m.Filter[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Columns) > 0 {
+		for iNdEx := len(m.Columns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Columns[iNdEx])
+			copy(dAtA[i:], m.Columns[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Columns[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportAdminsChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportAdminsChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportAdminsChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchGetAdminsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchGetAdminsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchGetAdminsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchGetAdminsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchGetAdminsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchGetAdminsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MissingIds) > 0 {
+		for iNdEx := len(m.MissingIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingIds[iNdEx])
+			copy(dAtA[i:], m.MissingIds[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.MissingIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Admins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Admin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.AdminOrder != 0 {
+		n += 1 + sovAdmin(uint64(m.AdminOrder))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.FirstName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.LastName)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.BirthDate)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Gender)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Salary != 0 {
+		n += 5
+	}
+	l = len(m.Biography)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.StartWorkYear)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.EndWorkYear)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.WorkYears != 0 {
+		n += 1 + sovAdmin(uint64(m.WorkYears))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.UpdatedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DeletedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 2 + sovAdmin(uint64(m.Version))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.TerminatedAt)
+	if l > 0 {
+		n += 2 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IfAdminExistsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetAdminReqById) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAdminsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAdminsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, e := range m.Admins {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovAdmin(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeAdminPasswordReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PhoneNumber)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Email)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeAdminPasswordResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAdminFieldReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAdminFieldResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IfAdminExistsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsExists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRefreshTokenAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RefreshToken)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateRefreshTokenAdminResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDeletedAdminsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuditChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuditEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdmin(uint64(m.Id))
+	}
+	l = len(m.EntityType)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.EntityId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ActorType)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for k, v := range m.Changes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovAdmin(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuditLogReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAuditLogResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovAdmin(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InviteAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Admin != nil {
+		l = m.Admin.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InviteAdminResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Invite != nil {
+		l = m.Invite.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Notified {
+		n += 2
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminInvite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.InvitedBy)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.AcceptedAt)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RevokedAt)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AcceptInviteReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListInvitesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovAdmin(uint64(m.Offset))
+	}
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListInvitesResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invites) > 0 {
+		for _, e := range m.Invites {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovAdmin(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeInviteReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InviteId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TerminateAdminReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.EndWorkYear)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SalaryChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAdmin(uint64(m.Id))
+	}
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Salary != 0 {
+		n += 5
+	}
+	l = len(m.CreatedAt)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSalaryHistoryReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminId)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSalaryHistoryResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovAdmin(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportAdminsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Columns) > 0 {
+		for _, s := range m.Columns {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Filter) > 0 {
+		for k, v := range m.Filter {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportAdminsChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchGetAdminsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchGetAdminsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, e := range m.Admins {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.MissingIds) > 0 {
+		for _, s := range m.MissingIds {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Admin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Admin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Admin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminOrder", wireType)
+			}
+			m.AdminOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminOrder |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BirthDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Salary = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Biography", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Biography = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartWorkYear = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkYear", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndWorkYear = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
+			}
+			m.WorkYears = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkYears |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TerminatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IfAdminExistsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IfAdminExistsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IfAdminExistsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAdminReqById) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAdminReqById: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAdminReqById: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAdminsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAdminsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAdminsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, &Admin{})
+			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeAdminPasswordReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeAdminPasswordReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteAdminReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeAdminPasswordResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeAdminPasswordResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAdminFieldReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAdminFieldReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAdminFieldResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAdminFieldResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IfAdminExistsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IfAdminExistsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IfAdminExistsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExists = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRefreshTokenAdminResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreAdminReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreAdminReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListDeletedAdminsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListDeletedAdminsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListDeletedAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Changes == nil {
+				m.Changes = make(map[string]*AuditChange)
+			}
+			var mapkey string
+			var mapvalue *AuditChange
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &AuditChange{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Changes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAuditLogReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAuditLogReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAuditLogReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAuditLogResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAuditLogResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAuditLogResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AuditEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InviteAdminReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,17 +8932,17 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Admin: wiretype end group for non-group")
+			return fmt.Errorf("proto: InviteAdminReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Admin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InviteAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2315,46 +8952,31 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminOrder", wireType)
+			if m.Admin == nil {
+				m.Admin = &Admin{}
 			}
-			m.AdminOrder = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AdminOrder |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Admin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2382,45 +9004,64 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InviteAdminResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirstName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InviteAdminResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InviteAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invite", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2430,29 +9071,33 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastName = string(dAtA[iNdEx:postIndex])
+			if m.Invite == nil {
+				m.Invite = &AdminInvite{}
+			}
+			if err := m.Invite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BirthDate", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -2462,27 +9107,15 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BirthDate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.Notified = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2510,11 +9143,62 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminInvite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminInvite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminInvite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2542,11 +9226,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2574,11 +9258,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.AdminId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2606,22 +9290,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gender = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Salary = float32(math.Float32frombits(v))
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Biography", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2649,11 +9322,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Biography = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartWorkYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2681,11 +9354,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartWorkYear = string(dAtA[iNdEx:postIndex])
+			m.InvitedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkYear", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2713,30 +9386,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndWorkYear = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkYears", wireType)
-			}
-			m.WorkYears = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkYears |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2764,11 +9418,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2796,11 +9450,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedAt = string(dAtA[iNdEx:postIndex])
+			m.AcceptedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2828,11 +9482,11 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedAt = string(dAtA[iNdEx:postIndex])
+			m.RevokedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2860,7 +9514,7 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedAt = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2884,7 +9538,7 @@ func (m *Admin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IfAdminExistsReq) Unmarshal(dAtA []byte) error {
+func (m *AcceptInviteReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2907,15 +9561,15 @@ func (m *IfAdminExistsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IfAdminExistsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptInviteReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IfAdminExistsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptInviteReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2943,11 +9597,11 @@ func (m *IfAdminExistsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2975,7 +9629,7 @@ func (m *IfAdminExistsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2999,7 +9653,7 @@ func (m *IfAdminExistsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAdminReqById) Unmarshal(dAtA []byte) error {
+func (m *ListInvitesReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3022,17 +9676,55 @@ func (m *GetAdminReqById) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAdminReqById: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListInvitesReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAdminReqById: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListInvitesReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3042,23 +9734,118 @@ func (m *GetAdminReqById) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminId = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3082,7 +9869,7 @@ func (m *GetAdminReqById) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
+func (m *ListInvitesResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3105,17 +9892,17 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAdminsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListInvitesResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListInvitesResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invites", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3125,16 +9912,31 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invites = append(m.Invites, &AdminInvite{})
+			if err := m.Invites[len(m.Invites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Offset = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3144,16 +9946,67 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeInviteReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeInviteReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeInviteReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3163,118 +10016,23 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAdmin
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdmin
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdmin
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAdmin(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Filter[mapkey] = mapvalue
+			m.InviteId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3298,7 +10056,7 @@ func (m *ListAdminsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
+func (m *TerminateAdminReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3321,17 +10079,17 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAdminsResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateAdminReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAdminsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3341,31 +10099,29 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admins = append(m.Admins, &Admin{})
-			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdminId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndWorkYear", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3375,11 +10131,24 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndWorkYear = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3402,7 +10171,7 @@ func (m *ListAdminsResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
+func (m *SalaryChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3425,17 +10194,17 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeAdminPasswordReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SalaryChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeAdminPasswordReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SalaryChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PhoneNumber", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3445,27 +10214,14 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PhoneNumber = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3493,11 +10249,22 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.AdminId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salary", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Salary = float32(math.Float32frombits(v))
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3525,7 +10292,7 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.CreatedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3549,7 +10316,7 @@ func (m *ChangeAdminPasswordReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
+func (m *ListSalaryHistoryReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,10 +10339,10 @@ func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteAdminReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSalaryHistoryReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSalaryHistoryReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3632,7 +10399,7 @@ func (m *DeleteAdminReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
+func (m *ListSalaryHistoryResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3655,17 +10422,51 @@ func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeAdminPasswordResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSalaryHistoryResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeAdminPasswordResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSalaryHistoryResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &SalaryChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3675,12 +10476,11 @@ func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3703,7 +10503,7 @@ func (m *ChangeAdminPasswordResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
+func (m *ExportAdminsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3726,15 +10526,47 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckAdminFieldReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportAdminsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckAdminFieldReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3762,13 +10594,13 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Columns = append(m.Columns, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3778,23 +10610,118 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Filter[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3818,7 +10745,7 @@ func (m *CheckAdminFieldReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
+func (m *ExportAdminsChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3841,17 +10768,17 @@ func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckAdminFieldResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportAdminsChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckAdminFieldResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportAdminsChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3861,12 +10788,26 @@ func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3889,7 +10830,7 @@ func (m *CheckAdminFieldResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IfAdminExistsResp) Unmarshal(dAtA []byte) error {
+func (m *BatchGetAdminsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3912,17 +10853,17 @@ func (m *IfAdminExistsResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IfAdminExistsResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchGetAdminsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IfAdminExistsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchGetAdminsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -3932,12 +10873,24 @@ func (m *IfAdminExistsResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -3960,7 +10913,7 @@ func (m *IfAdminExistsResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
+func (m *BatchGetAdminsResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,17 +10936,17 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchGetAdminsResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchGetAdminsResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -4003,27 +10956,29 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Admins = append(m.Admins, &Admin{})
+			if err := m.Admins[len(m.Admins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4051,7 +11006,7 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefreshToken = string(dAtA[iNdEx:postIndex])
+			m.MissingIds = append(m.MissingIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4075,77 +11030,6 @@ func (m *UpdateRefreshTokenAdminReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRefreshTokenAdminResp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRefreshTokenAdminResp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Status = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipAdmin(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,44 +1,66 @@
 package app
 
 import (
+	"context"
 	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/delivery/grpc/kafka/handlers"
 	grpc_server "dennic_user_service/internal/delivery/grpc/server"
 	invest_grpc "dennic_user_service/internal/delivery/grpc/services"
 	"dennic_user_service/internal/infrastructure/grpc_service_clients"
 	"dennic_user_service/internal/infrastructure/kafka"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/infrastructure/repository/cached"
 	adminRepo "dennic_user_service/internal/infrastructure/repository/postgresql/admin"
+	auditRepo "dennic_user_service/internal/infrastructure/repository/postgresql/audit"
+	medicalRepo "dennic_user_service/internal/infrastructure/repository/postgresql/medical"
+	roleRepo "dennic_user_service/internal/infrastructure/repository/postgresql/role"
 	userRepo "dennic_user_service/internal/infrastructure/repository/postgresql/user"
+	"dennic_user_service/internal/pkg/cache"
 	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/envelope"
+	"dennic_user_service/internal/pkg/health"
 	"dennic_user_service/internal/pkg/logger"
+	"dennic_user_service/internal/pkg/metrics"
+	"dennic_user_service/internal/pkg/migrate"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/pkg/postgres"
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
+	"dennic_user_service/migrations"
 	"fmt"
-	"time"
+	"io"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type App struct {
 	Config         *config.Config
 	Logger         *zap.Logger
+	LogLevel       zap.AtomicLevel
 	DB             *postgres.PostgresDB
 	GrpcServer     *grpc.Server
+	Health         *health.Checker
+	MetricsServer  *metrics.Server
+	PurgeJob       *PurgeJob
+	TenureJob      *TenureJob
+	DuplicatesJob  *DuplicatesJob
 	ShutdownOTLP   func() error
 	ServiceClients grpc_service_clients.ServiceClients
 	BrokerProducer event.BrokerProducer
 	BrokerConsumer event.BrokerConsumer
+	Cache          cache.Cache
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
 	// init logger
-	logger, err := logger.New(cfg.LogLevel, cfg.Environment, cfg.APP+".log")
+	logger, logLevel, err := logger.New(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -46,10 +68,11 @@ func NewApp(cfg *config.Config) (*App, error) {
 	kafkaProducer := kafka.NewProducer(cfg, logger)
 	kafkaConsumer := kafka.NewConsumer(logger)
 
-	// otlp collector initialization
+	// otlp collector initialization, tracing is optional so a bad collector config must not stop the service
 	shutdownOTLP, err := otlp.InitOTLPProvider(cfg)
 	if err != nil {
-		return nil, err
+		logger.Error("otlp collector disabled", zap.Error(err))
+		shutdownOTLP = func() error { return nil }
 	}
 
 	// init db
@@ -57,6 +80,25 @@ func NewApp(cfg *config.Config) (*App, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// the service only runs against a schema at the version it was built for
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrator.Check(context.Background()); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// db pool metrics
+	if err := metrics.RegisterDBPool(db); err != nil {
+		return nil, fmt.Errorf("error during register db pool metrics: %w", err)
+	}
+
+	// health checker initialization
+	healthChecker := health.New(logger, db, cfg.Kafka.Address, cfg.HealthCheck.Interval, cfg.HealthCheck.Timeout)
+
 	consumerApp, err := NewUserCreateConsumerCLI(cfg, logger, db, kafkaConsumer)
 	if err != nil {
 		return nil, err
@@ -65,40 +107,45 @@ func NewApp(cfg *config.Config) (*App, error) {
 	grpcServer := grpc.NewServer(
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_ctxtags.StreamServerInterceptor(),
+			otelgrpc.StreamServerInterceptor(),
+			grpc_server.StreamInterceptorTraceTags(),
 			grpc_zap.StreamServerInterceptor(logger),
+			grpc_server.StreamInterceptorMetrics(),
+			grpc_server.StreamInterceptorErrors(),
 			grpc_recovery.StreamServerInterceptor(),
+			grpc_server.StreamInterceptorData(logger),
 		)),
 		grpc.UnaryInterceptor(grpc_server.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
 				grpc_ctxtags.UnaryServerInterceptor(),
+				otelgrpc.UnaryServerInterceptor(),
+				grpc_server.UnaryInterceptorTraceTags(),
 				grpc_zap.UnaryServerInterceptor(logger),
+				grpc_server.UnaryInterceptorMetrics(),
+				grpc_server.UnaryInterceptorErrors(),
 				grpc_recovery.UnaryServerInterceptor(),
 			),
 			grpc_server.UnaryInterceptorData(logger),
 		)),
 	)
+	healthpb.RegisterHealthServer(grpcServer, healthChecker.Server())
 
 	return &App{
 		Config:         cfg,
 		Logger:         logger,
+		LogLevel:       logLevel,
 		DB:             db,
 		GrpcServer:     grpcServer,
+		Health:         healthChecker,
+		MetricsServer:  metrics.NewServer(cfg.Metrics.Port),
 		ShutdownOTLP:   shutdownOTLP,
 		BrokerProducer: kafkaProducer,
 		BrokerConsumer: consumerApp.BrokerConsumer,
+		Cache:          newCache(cfg),
 	}, nil
 }
 
 func (a *App) Run() error {
-	var (
-		contextTimeout time.Duration
-	)
-
-	// context timeout initialization
-	contextTimeout, err := time.ParseDuration(a.Config.Context.Timeout)
-	if err != nil {
-		return fmt.Errorf("error during parse duration for context timeout : %w", err)
-	}
 	// Initialize Service Clients
 	serviceClients, err := grpc_service_clients.New(a.Config)
 	if err != nil {
@@ -107,15 +154,85 @@ func (a *App) Run() error {
 	a.ServiceClients = serviceClients
 
 	// repositories initialization
-	userRepo := userRepo.NewUserRepo(a.DB)
-	adminRepo := adminRepo.NewAdminRepo(a.DB)
+	var (
+		userRepo  repository.UserStorageI  = userRepo.NewUserRepo(a.DB)
+		adminRepo repository.AdminStorageI = adminRepo.NewAdminRepo(a.DB)
+	)
+	if a.Cache != nil {
+		// replicas sharing redis drop what they change for all, in-process caches hear of it through kafka
+		var publisher cached.Publisher
+		if a.Config.Cache.Backend == "lru" {
+			publisher = a.BrokerProducer
+			if err := handlers.NewCacheInvalidatedHandler(a.Config, a.BrokerConsumer, a.Logger, a.Cache).HandlerEvents(); err != nil {
+				return err
+			}
+		}
+		userRepo = cached.NewUserRepo(userRepo, a.Cache, publisher, a.Config.Cache.TTL, a.Config.Cache.NotFoundTTL)
+		adminRepo = cached.NewAdminRepo(adminRepo, a.Cache, publisher, a.Config.Cache.TTL, a.Config.Cache.NotFoundTTL)
+	}
+	auditRepo := auditRepo.NewAuditRepo(a.DB)
+	roleRepo := roleRepo.NewRoleRepo(a.DB)
+	// without MEDICAL_KEY the rest of the service runs and medical profiles fail
+	var keyring *envelope.Keyring
+	if a.Config.Medical.Key != "" {
+		keyring, err = envelope.Parse(a.Config.Medical.KeyId, a.Config.Medical.Key)
+		if err != nil {
+			return fmt.Errorf("error during parse medical key: %w", err)
+		}
+	}
+	medicalRepo := medicalRepo.NewMedicalRepo(a.DB, keyring)
 
 	// usecase initialization
-	userUsecase := usecase.NewUserService(contextTimeout, userRepo)
-	adminUsecase := usecase.NewAdminService(contextTimeout, adminRepo)
+	roleUsecase := usecase.NewRoleService(a.Config.Context.Timeout, roleRepo, a.Config.Authz.PermissionCacheTTL)
+	userUsecase := usecase.NewUserService(a.Config.Context.Timeout, userRepo, auditRepo, roleUsecase)
+	adminUsecase := usecase.NewAdminService(a.Config.Context.Timeout, adminRepo, auditRepo, roleUsecase)
+	inviteUsecase := usecase.NewInviteService(a.Config.Context.Timeout, adminRepo, auditRepo, roleUsecase,
+		a.ServiceClients.Notifier(), a.Config.Invite.TTL)
+	emailVerificationUsecase := usecase.NewEmailVerificationService(a.Config.Context.Timeout, userRepo, auditRepo, roleUsecase,
+		a.ServiceClients.Notifier(), a.Config.EmailVerification.TTL)
+	medicalUsecase := usecase.NewMedicalService(a.Config.Context.Timeout, medicalRepo, userRepo, auditRepo, roleUsecase)
+	consentUsecase := usecase.NewConsentService(a.Config.Context.Timeout, userRepo, auditRepo, roleUsecase)
+	exportUsecase := usecase.NewExportService(a.Config.Export.Timeout, userRepo, adminRepo, roleUsecase)
+	// without a keyring medical profiles can not be read, so a merge leaves them where they are
+	var mergeMedicalRepo repository.MedicalStorageI
+	if keyring != nil {
+		mergeMedicalRepo = medicalRepo
+	}
+	mergeUsecase := usecase.NewMergeService(a.Config.Context.Timeout, userRepo, mergeMedicalRepo, auditRepo, roleUsecase,
+		a.Config.Duplicates.Timeout, a.Config.Duplicates.Threshold)
+
+	pb.RegisterUserServiceServer(a.GrpcServer, invest_grpc.NewUserRPC(a.Logger, userUsecase, emailVerificationUsecase,
+		medicalUsecase, consentUsecase, exportUsecase, mergeUsecase, a.BrokerProducer))
+	pb.RegisterAdminServiceServer(a.GrpcServer, invest_grpc.NewAdminRPC(a.Logger, adminUsecase, inviteUsecase, exportUsecase,
+		a.BrokerProducer))
+	pb.RegisterRoleServiceServer(a.GrpcServer, invest_grpc.NewRoleRPC(a.Logger, roleUsecase))
+
+	// erase accounts soft-deleted longer than the retention period
+	a.PurgeJob = NewPurgeJob(a.Logger, a.Config.Purge.Interval, a.Config.Purge.Retention, map[string]Purger{
+		"user":  userUsecase,
+		"admin": adminUsecase,
+	})
+	go a.PurgeJob.Run()
+
+	// keep the work years of admins current
+	a.TenureJob = NewTenureJob(a.Logger, a.Config.Tenure.Interval, adminUsecase)
+	go a.TenureJob.Run()
+
+	// list users registered twice for review
+	a.DuplicatesJob = NewDuplicatesJob(a.Logger, a.Config.Duplicates.Interval, mergeUsecase)
+	go a.DuplicatesJob.Run()
+
+	// dependency health checks
+	go a.Health.Run()
+
+	// prometheus metrics endpoint
+	go func() {
+		a.Logger.Info("Metrics Server Listening", zap.String("url", a.Config.Metrics.Port))
+		if err := a.MetricsServer.Run(); err != nil {
+			a.Logger.Error("metrics server", zap.Error(err))
+		}
+	}()
 
-	pb.RegisterUserServiceServer(a.GrpcServer, invest_grpc.NewUserRPC(a.Logger, userUsecase, a.BrokerProducer))
-	pb.RegisterAdminServiceServer(a.GrpcServer, invest_grpc.NewAdminRPC(a.Logger, adminUsecase, a.BrokerProducer))
 	a.Logger.Info("gRPC Server Listening", zap.String("url", a.Config.RPCPort))
 	if err := grpc_server.Run(a.Config, a.GrpcServer); err != nil {
 		return fmt.Errorf("gRPC fatal to serve grpc server over %s %w", a.Config.RPCPort, err)
@@ -124,14 +241,54 @@ func (a *App) Run() error {
 	return nil
 }
 
+// ReloadLogLevel re-reads the configuration and applies its log level, it runs on SIGHUP so only
+// whoever can signal the process changes verbosity
+func (a *App) ReloadLogLevel() error {
+	cfg, err := config.New()
+	if err != nil {
+		return err
+	}
+
+	if err := a.LogLevel.UnmarshalText([]byte(cfg.LogLevel)); err != nil {
+		return err
+	}
+	a.Logger.Info("log level changed", zap.String("level", a.LogLevel.String()))
+
+	return nil
+}
+
 func (a *App) Stop() {
-	// close broker producer
+	// report NOT_SERVING before connections go away
+	a.Health.Shutdown()
+	// stop the jobs before the database is closed
+	if a.PurgeJob != nil {
+		a.PurgeJob.Shutdown()
+	}
+	if a.TenureJob != nil {
+		a.TenureJob.Shutdown()
+	}
+	if a.DuplicatesJob != nil {
+		a.DuplicatesJob.Shutdown()
+	}
+	// close broker producer and consumer
 	a.BrokerProducer.Close()
+	a.BrokerConsumer.Close()
+	// close the redis connections of the cache
+	if closer, ok := a.Cache.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			a.Logger.Error("close cache", zap.Error(err))
+		}
+	}
 	// closing client service connections
 	a.ServiceClients.Close()
 	// stop gRPC server
 	a.GrpcServer.Stop()
 
+	// stop metrics server
+	if err := a.MetricsServer.Shutdown(context.Background()); err != nil {
+		a.Logger.Error("shutdown metrics server", zap.Error(err))
+	}
+
 	// database connection
 	a.DB.Close()
 
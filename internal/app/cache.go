@@ -0,0 +1,23 @@
+package app
+
+import (
+	"dennic_user_service/internal/pkg/cache"
+	"dennic_user_service/internal/pkg/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// newCache returns the cache of users and admins read by id, nil when CACHE_BACKEND is none
+func newCache(cfg *config.Config) cache.Cache {
+	switch cfg.Cache.Backend {
+	case "lru":
+		return cache.NewLRU(cfg.Cache.Size)
+	case "redis":
+		return cache.NewRedis(redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Address,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		}), cfg.APP+":")
+	}
+	return nil
+}
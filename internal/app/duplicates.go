@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DuplicateFinder searches users for people registered twice
+type DuplicateFinder interface {
+	FindDuplicates(ctx context.Context) (int64, error)
+}
+
+// DuplicatesJob refreshes the duplicate users listed for review every interval
+type DuplicatesJob struct {
+	logger   *zap.Logger
+	interval time.Duration
+	finder   DuplicateFinder
+	stop     chan struct{}
+	once     sync.Once
+}
+
+func NewDuplicatesJob(logger *zap.Logger, interval time.Duration, finder DuplicateFinder) *DuplicatesJob {
+	return &DuplicatesJob{
+		logger:   logger.Named("duplicates"),
+		interval: interval,
+		finder:   finder,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Run searches every interval until Shutdown is called, a zero interval disables the job
+func (j *DuplicatesJob) Run() {
+	if j.interval <= 0 {
+		j.logger.Info("duplicate search disabled")
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+			j.find()
+		}
+	}
+}
+
+func (j *DuplicatesJob) Shutdown() {
+	j.once.Do(func() {
+		close(j.stop)
+	})
+}
+
+func (j *DuplicatesJob) find() {
+	found, err := j.finder.FindDuplicates(reqinfo.Internal(context.Background()))
+	if err != nil {
+		j.logger.Error("find duplicate users", zap.Error(err))
+		return
+	}
+	j.logger.Info("duplicate users found", zap.Int64("count", found))
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Purger hard-deletes accounts soft-deleted longer than retention ago
+type Purger interface {
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// PurgeJob erases expired soft-deleted accounts every interval
+type PurgeJob struct {
+	logger    *zap.Logger
+	interval  time.Duration
+	retention time.Duration
+	purgers   map[string]Purger
+	stop      chan struct{}
+	once      sync.Once
+}
+
+func NewPurgeJob(logger *zap.Logger, interval, retention time.Duration, purgers map[string]Purger) *PurgeJob {
+	return &PurgeJob{
+		logger:    logger.Named("purge"),
+		interval:  interval,
+		retention: retention,
+		purgers:   purgers,
+		stop:      make(chan struct{}),
+	}
+}
+
+// Run purges every interval until Shutdown is called, a zero interval disables the job
+func (j *PurgeJob) Run() {
+	if j.interval <= 0 {
+		j.logger.Info("purge of deleted accounts disabled")
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+			j.purge()
+		}
+	}
+}
+
+func (j *PurgeJob) Shutdown() {
+	j.once.Do(func() {
+		close(j.stop)
+	})
+}
+
+func (j *PurgeJob) purge() {
+	for kind, purger := range j.purgers {
+		purged, err := purger.Purge(reqinfo.Internal(context.Background()), j.retention)
+		if err != nil {
+			j.logger.Error("purge deleted accounts", zap.String("kind", kind), zap.Error(err))
+			continue
+		}
+		if purged != 0 {
+			j.logger.Info("deleted accounts purged", zap.String("kind", kind), zap.Int64("count", purged))
+		}
+	}
+}
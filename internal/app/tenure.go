@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// TenureComputer refreshes the work years of admins
+type TenureComputer interface {
+	RecomputeTenure(ctx context.Context) (int64, error)
+}
+
+// TenureJob recomputes the work years of admins every interval, they grow while time passes
+type TenureJob struct {
+	logger   *zap.Logger
+	interval time.Duration
+	computer TenureComputer
+	stop     chan struct{}
+	once     sync.Once
+}
+
+func NewTenureJob(logger *zap.Logger, interval time.Duration, computer TenureComputer) *TenureJob {
+	return &TenureJob{
+		logger:   logger.Named("tenure"),
+		interval: interval,
+		computer: computer,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Run recomputes every interval until Shutdown is called, a zero interval disables the job
+func (j *TenureJob) Run() {
+	if j.interval <= 0 {
+		j.logger.Info("tenure recomputation disabled")
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.stop:
+			return
+		case <-ticker.C:
+			j.recompute()
+		}
+	}
+}
+
+func (j *TenureJob) Shutdown() {
+	j.once.Do(func() {
+		close(j.stop)
+	})
+}
+
+func (j *TenureJob) recompute() {
+	updated, err := j.computer.RecomputeTenure(reqinfo.Internal(context.Background()))
+	if err != nil {
+		j.logger.Error("recompute work years", zap.Error(err))
+		return
+	}
+	if updated != 0 {
+		j.logger.Info("work years recomputed", zap.Int64("count", updated))
+	}
+}
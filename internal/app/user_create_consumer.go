@@ -3,9 +3,10 @@ package app
 import (
 	"dennic_user_service/internal/delivery/grpc/kafka/handlers"
 	"dennic_user_service/internal/infrastructure/kafka"
+	auditRepo "dennic_user_service/internal/infrastructure/repository/postgresql/audit"
+	roleRepo "dennic_user_service/internal/infrastructure/repository/postgresql/role"
 	"dennic_user_service/internal/infrastructure/repository/postgresql/user"
 	"dennic_user_service/internal/pkg/config"
-	logPkg "dennic_user_service/internal/pkg/logger"
 	"dennic_user_service/internal/pkg/postgres"
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
@@ -22,14 +23,12 @@ type UserCreateConsumerCLI struct {
 }
 
 func NewUserCreateConsumerCLI(config *config.Config, logger *zap.Logger, db *postgres.PostgresDB, brokerConsumer event.BrokerConsumer) (*UserCreateConsumerCLI, error) {
-	logger, err := logPkg.New(config.LogLevel, config.Environment, config.APP+"_cli"+".log")
-	if err != nil {
-		return nil, err
-	}
+	// share the service log file instead of writing a separate one
+	logger = logger.Named("user_create_consumer")
 
 	consumer := kafka.NewConsumer(logger)
 
-	db, err = postgres.New(config)
+	db, err := postgres.New(config)
 	if err != nil {
 		return nil, err
 	}
@@ -46,9 +45,12 @@ func (c *UserCreateConsumerCLI) Run() error {
 	fmt.Print("consume is running ....")
 	// repo init
 	userRepo := postgresql.NewUserRepo(c.DB)
+	auditRepo := auditRepo.NewAuditRepo(c.DB)
+	roleRepo := roleRepo.NewRoleRepo(c.DB)
 
 	// usecase init
-	userUsecase := usecase.NewUserService(c.DB.Config().ConnConfig.ConnectTimeout, userRepo)
+	roleUsecase := usecase.NewRoleService(c.DB.Config().ConnConfig.ConnectTimeout, roleRepo, c.Config.Authz.PermissionCacheTTL)
+	userUsecase := usecase.NewUserService(c.DB.Config().ConnConfig.ConnectTimeout, userRepo, auditRepo, roleUsecase)
 
 	eventHandler := handlers.NewUserCreateHandler(c.Config, c.BrokerConsumer, c.Logger, userUsecase)
 
@@ -10,15 +10,19 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var (
-	errNotFound   *entity.ErrNotFound
-	errConflict   *entity.ErrConflict
-	errValidation *entity.ErrValidation
-)
-
+// ErrorStatus maps err to a grpc status, it runs for every failing request at once so the
+// targets of errors.As are its own
 func ErrorStatus(ctx context.Context, err error) *status.Status {
 	var (
-		st *status.Status
+		st              *status.Status
+		errNotFound     *entity.ErrNotFound
+		errConflict     *entity.ErrConflict
+		errValidation   *entity.ErrValidation
+		errPrecondition *entity.ErrPreconditionFailed
+		errNoRequired   *entity.ErrNoRequiredParameter
+		errDenied       *entity.ErrPermissionDenied
+		errInactive     *entity.ErrAccountInactive
+		errUnpublished  *entity.ErrEventNotPublished
 	)
 	switch {
 	// error not found
@@ -27,6 +31,21 @@ func ErrorStatus(ctx context.Context, err error) *status.Status {
 	// error conflict
 	case errors.As(err, &errConflict):
 		st = status.New(codes.AlreadyExists, err.Error())
+	// error precondition failed
+	case errors.As(err, &errPrecondition):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	// error account inactive
+	case errors.As(err, &errInactive):
+		st = status.New(codes.FailedPrecondition, err.Error())
+	// error permission denied
+	case errors.As(err, &errDenied):
+		st = status.New(codes.PermissionDenied, err.Error())
+	// error event not published, the client retries
+	case errors.As(err, &errUnpublished):
+		st = status.New(codes.Unavailable, err.Error())
+	// error no required parameter
+	case errors.As(err, &errNoRequired):
+		st = status.New(codes.InvalidArgument, err.Error())
 	// error validation errors
 	case errors.As(err, &errValidation):
 		st = status.New(codes.InvalidArgument, codes.InvalidArgument.String())
@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	epb "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+type ErrorStatusTestSuite struct {
+	suite.Suite
+}
+
+func (s *ErrorStatusTestSuite) TestCodes() {
+	s.Equal(codes.NotFound, ErrorStatus(context.Background(), entity.NewErrNotFound("user")).Code())
+	s.Equal(codes.PermissionDenied, ErrorStatus(context.Background(), entity.NewErrPermissionDenied(entity.PermissionUsersRead)).Code())
+	s.Equal(codes.Unavailable, ErrorStatus(context.Background(), entity.NewErrEventNotPublished("user.erased", errors.New("boom"))).Code())
+	s.Equal(codes.Internal, ErrorStatus(context.Background(), errors.New("boom")).Code())
+}
+
+// concurrent requests each get the field violations of their own error
+func (s *ErrorStatusTestSuite) TestConcurrentValidation() {
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			field := fmt.Sprintf("field_%d", i)
+			errValidation := entity.NewErrValidation()
+			errValidation.Err = errors.New("invalid")
+			errValidation.Errors[field] = "invalid"
+
+			st := ErrorStatus(context.Background(), errValidation)
+			s.Equal(codes.InvalidArgument, st.Code())
+			if !s.Len(st.Details(), 1) {
+				return
+			}
+			violations := st.Details()[0].(*epb.BadRequest).FieldViolations
+			if s.Len(violations, 1) {
+				s.Equal(field, violations[0].Field)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestErrorStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(ErrorStatusTestSuite))
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/kafka"
+	"dennic_user_service/internal/infrastructure/repository/cached"
+	"dennic_user_service/internal/pkg/cache"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/usecase/event"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+)
+
+type cacheInvalidatedHandler struct {
+	config         *config.Config
+	brokerConsumer event.BrokerConsumer
+	logger         *zap.Logger
+	cache          cache.Cache
+}
+
+// NewCacheInvalidatedHandler drops the accounts other replicas changed from the in-process cache,
+// every replica reads the events from its start in a consumer group of its own
+func NewCacheInvalidatedHandler(config *config.Config,
+	brokerConsumer event.BrokerConsumer,
+	logger *zap.Logger,
+	cache cache.Cache) *cacheInvalidatedHandler {
+	return &cacheInvalidatedHandler{
+		config:         config,
+		brokerConsumer: brokerConsumer,
+		logger:         logger,
+		cache:          cache,
+	}
+}
+
+func (h *cacheInvalidatedHandler) HandlerEvents() error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("error during resolve the consumer group of the replica: %w", err)
+	}
+
+	consumerConfig := kafka.NewBroadcastConsumerConfig(
+		h.config.Kafka.Address,
+		h.config.Kafka.Topic.CacheInvalidated,
+		h.config.APP+"-cache-"+hostname,
+		func(ctx context.Context, key, value []byte) error {
+			var invalidated entity.CacheInvalidated
+
+			if err := json.Unmarshal(value, &invalidated); err != nil {
+				return err
+			}
+
+			return h.cache.Delete(ctx, cached.Key(invalidated.EntityType, invalidated.EntityId))
+		},
+	)
+
+	h.brokerConsumer.RegisterConsumer(consumerConfig)
+	h.brokerConsumer.Run()
+
+	return nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/kafka"
 	"dennic_user_service/internal/pkg/config"
@@ -42,6 +43,10 @@ func (h *userCreateHandler) HandlerEvents() error {
 			if err := json.Unmarshal(value, &user); err != nil {
 				return err
 			}
+			// Create checks the version against the current terms, a message without one is malformed
+			if user.AcceptedTermsVersion == 0 {
+				return errors.New("api.user.create message without AcceptedTermsVersion")
+			}
 
 			if _, err := h.userUsecase.Create(ctx, user); err != nil {
 				return err
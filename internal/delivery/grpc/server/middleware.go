@@ -2,10 +2,22 @@ package server
 
 import (
 	"context"
+	delivery "dennic_user_service/internal/delivery/grpc"
+	"dennic_user_service/internal/pkg/logger"
+	"dennic_user_service/internal/pkg/metrics"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"net"
+	"strings"
+	"time"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 func UnaryInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
@@ -24,13 +36,129 @@ func UnaryInterceptor(interceptors ...grpc.UnaryServerInterceptor) grpc.UnarySer
 	}
 }
 
+// UnaryInterceptorData stores the actor, request id and client ip sent by the gateway in the context,
+// the usecases read them back for the audit log
 func UnaryInterceptorData(logger *zap.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if ok {
-			_ = md
-			//...
+		return handler(withRequestInfo(ctx), req)
+	}
+}
+
+func StreamInterceptorData(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = withRequestInfo(ss.Context())
+		return handler(srv, wrapped)
+	}
+}
+
+// withRequestInfo marks every request with its info, a request is never an internal call so one
+// without an actor holds no permission
+func withRequestInfo(ctx context.Context) context.Context {
+	var info reqinfo.Info
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		info.ActorId = first(md.Get(reqinfo.MetadataActorId))
+		info.ActorType = first(md.Get(reqinfo.MetadataActorType))
+		info.RequestId = first(md.Get(reqinfo.MetadataRequestId))
+		// the left-most forwarded address is the original client
+		if forwardedFor := first(md.Get(reqinfo.MetadataForwardedFor)); forwardedFor != "" {
+			info.IP = strings.TrimSpace(strings.Split(forwardedFor, ",")[0])
 		}
+	}
+	if info.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			info.IP = p.Addr.String()
+			if host, _, err := net.SplitHostPort(info.IP); err == nil {
+				info.IP = host
+			}
+		}
+	}
+
+	if info.RequestId != "" {
+		grpc_ctxtags.Extract(ctx).Set("request_id", info.RequestId)
+	}
+
+	return reqinfo.With(ctx, info)
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func UnaryInterceptorMetrics() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func StreamInterceptorMetrics() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// UnaryInterceptorErrors turns domain errors returned by the handlers into grpc statuses
+func UnaryInterceptorErrors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, statusError(ctx, err)
+	}
+}
+
+func StreamInterceptorErrors() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return statusError(ss.Context(), handler(srv, ss))
+	}
+}
+
+func statusError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return delivery.Error(ctx, err)
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	metrics.GRPCHandled.WithLabelValues(method, code).Inc()
+	metrics.GRPCHandlingSeconds.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
+
+// UnaryInterceptorTraceTags puts the current trace and span ids into the request tags,
+// so every line written by grpc_zap and ctxzap carries them
+func UnaryInterceptorTraceTags() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		setTraceTags(ctx)
 		return handler(ctx, req)
 	}
 }
+
+func StreamInterceptorTraceTags() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		setTraceTags(ss.Context())
+		return handler(srv, ss)
+	}
+}
+
+func setTraceTags(ctx context.Context) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return
+	}
+
+	grpc_ctxtags.Extract(ctx).
+		Set(logger.TraceIDKey, spanContext.TraceID().String()).
+		Set(logger.SpanIDKey, spanContext.SpanID().String())
+}
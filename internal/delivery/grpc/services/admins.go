@@ -1,9 +1,11 @@
 package services
 
 import (
+	"bufio"
 	"context"
 	pb "dennic_user_service/genproto/user_service"
 	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/logger"
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
 	"time"
@@ -15,18 +17,27 @@ import (
 type adminRPC struct {
 	logger         *zap.Logger
 	admin          usecase.AdminStorageI
+	invite         usecase.InviteStorageI
+	export         usecase.ExportStorageI
 	brokerProducer event.BrokerProducer
 }
 
-func NewAdminRPC(logger *zap.Logger, admin usecase.AdminStorageI,
-	brokerProducer event.BrokerProducer) pb.AdminServiceServer {
+func NewAdminRPC(logger *zap.Logger, admin usecase.AdminStorageI, invite usecase.InviteStorageI,
+	export usecase.ExportStorageI, brokerProducer event.BrokerProducer) pb.AdminServiceServer {
 	return &adminRPC{
 		logger:         logger,
 		admin:          admin,
+		invite:         invite,
+		export:         export,
 		brokerProducer: brokerProducer,
 	}
 }
 
+// log returns the rpc logger annotated with the trace of the current request
+func (a adminRPC) log(ctx context.Context) *zap.Logger {
+	return logger.WithTrace(ctx, a.logger)
+}
+
 func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error) {
 
 	req := entity.Admin{
@@ -50,7 +61,7 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 	}
 	AdminId, err := a.admin.Create(ctx, &req)
 	if err != nil {
-		a.logger.Error("Create admin error", zap.Error(err))
+		a.log(ctx).Error("Create admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -58,7 +69,7 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 	Params["id"] = AdminId
 	resp, err := a.admin.Get(ctx, Params)
 	if err != nil {
-		a.logger.Error("Create admin error", zap.Error(err))
+		a.log(ctx).Error("Create admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -79,7 +90,9 @@ func (a adminRPC) Create(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		EndWorkYear:   resp.EndWorkYear,
 		WorkYears:     resp.WorkYears,
 		RefreshToken:  resp.RefreshToken,
+		Version:       resp.Version,
 		CreatedAt:     resp.CreatedAt.String(),
+		TerminatedAt:  formatTime(resp.TerminatedAt),
 	}, nil
 }
 
@@ -91,7 +104,7 @@ func (a adminRPC) Get(ctx context.Context, id *pb.GetAdminReqById) (*pb.Admin, e
 	resp, err := a.admin.Get(ctx, reqMap)
 
 	if err != nil {
-		a.logger.Error("get admin error", zap.Error(err))
+		a.log(ctx).Error("get admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -112,7 +125,9 @@ func (a adminRPC) Get(ctx context.Context, id *pb.GetAdminReqById) (*pb.Admin, e
 		EndWorkYear:   resp.EndWorkYear,
 		WorkYears:     resp.WorkYears,
 		RefreshToken:  resp.RefreshToken,
+		Version:       resp.Version,
 		CreatedAt:     resp.CreatedAt.String(),
+		TerminatedAt:  formatTime(resp.TerminatedAt),
 		UpdatedAt:     resp.UpdatedAt.String(),
 	}, nil
 }
@@ -122,7 +137,7 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 	resp, err := a.admin.List(ctx, req.Limit, req.Offset, req.Filter)
 
 	if err != nil {
-		a.logger.Error("get all admin error", zap.Error(err))
+		a.log(ctx).Error("get all admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -146,8 +161,10 @@ func (a adminRPC) ListAdmins(ctx context.Context, req *pb.ListAdminsReq) (*pb.Li
 			EndWorkYear:   in.EndWorkYear,
 			WorkYears:     in.WorkYears,
 			RefreshToken:  in.RefreshToken,
+			Version:       in.Version,
 			CreatedAt:     in.CreatedAt.String(),
 			UpdatedAt:     in.UpdatedAt.String(),
+			TerminatedAt:  formatTime(in.TerminatedAt),
 		})
 	}
 
@@ -161,8 +178,8 @@ func (a adminRPC) Update(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		AdminOrder:    admin.AdminOrder,
 		Role:          admin.Role,
 		FirstName:     admin.FirstName,
-		LastName:      admin.FirstName,
-		BirthDate:     admin.Biography,
+		LastName:      admin.LastName,
+		BirthDate:     admin.BirthDate,
 		PhoneNumber:   admin.PhoneNumber,
 		Email:         admin.Email,
 		Password:      admin.Password,
@@ -173,36 +190,18 @@ func (a adminRPC) Update(ctx context.Context, admin *pb.Admin) (*pb.Admin, error
 		EndWorkYear:   admin.EndWorkYear,
 		WorkYears:     admin.WorkYears,
 		RefreshToken:  admin.RefreshToken,
-		UpdatedAt:     time.Now(),
+		Version:       admin.Version,
 	}
 
-	err := a.admin.Update(ctx, &req)
+	err := a.admin.Update(ctx, &req, admin.GetUpdateMask().GetPaths())
 
 	if err != nil {
-		a.logger.Error("update admin error", zap.Error(err))
+		a.log(ctx).Error("update admin error", zap.Error(err))
 		return nil, err
 	}
 
-	return &pb.Admin{
-		Id:            req.Id,
-		AdminOrder:    req.AdminOrder,
-		Role:          req.Role,
-		FirstName:     req.FirstName,
-		LastName:      req.LastName,
-		BirthDate:     req.BirthDate,
-		PhoneNumber:   req.PhoneNumber,
-		Email:         req.Email,
-		Password:      req.Password,
-		Gender:        req.Gender,
-		Salary:        req.Salary,
-		Biography:     req.Biography,
-		StartWorkYear: req.StartWorkYear,
-		EndWorkYear:   req.EndWorkYear,
-		WorkYears:     req.WorkYears,
-		RefreshToken:  req.RefreshToken,
-		CreatedAt:     req.CreatedAt.String(),
-		UpdatedAt:     req.UpdatedAt.String(),
-	}, nil
+	// fields outside the mask are kept as stored, so read the admin back
+	return a.Get(ctx, &pb.GetAdminReqById{AdminId: req.Id})
 }
 
 func (a adminRPC) Delete(ctx context.Context, id *pb.DeleteAdminReq) (resp *emptypb.Empty, err error) {
@@ -212,7 +211,7 @@ func (a adminRPC) Delete(ctx context.Context, id *pb.DeleteAdminReq) (resp *empt
 
 	err = a.admin.Delete(ctx, id.AdminId)
 	if err != nil {
-		a.logger.Error("delete admin error", zap.Error(err))
+		a.log(ctx).Error("delete admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -228,7 +227,7 @@ func (a adminRPC) CheckField(ctx context.Context, req *pb.CheckAdminFieldReq) (*
 
 	resp, err := a.admin.CheckField(ctx, &reqAdmin)
 	if err != nil {
-		a.logger.Error("delete admin error", zap.Error(err))
+		a.log(ctx).Error("delete admin error", zap.Error(err))
 		return nil, err
 	}
 	response := &pb.CheckAdminFieldResp{
@@ -248,7 +247,7 @@ func (a adminRPC) IfExists(ctx context.Context, phone *pb.IfAdminExistsReq) (res
 	entityResp, err := a.admin.IfExists(ctx, &req)
 
 	if err != nil {
-		a.logger.Error("delete admin error", zap.Error(err))
+		a.log(ctx).Error("delete admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -268,7 +267,7 @@ func (a adminRPC) ChangePassword(ctx context.Context, phone *pb.ChangeAdminPassw
 	}
 	status, err := a.admin.ChangePassword(ctx, &req)
 	if err != nil {
-		a.logger.Error("delete admin error", zap.Error(err))
+		a.log(ctx).Error("delete admin error", zap.Error(err))
 		return nil, err
 	}
 	resp = &pb.ChangeAdminPasswordResp{
@@ -285,7 +284,7 @@ func (a adminRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTo
 	}
 	status, err := a.admin.UpdateRefreshToken(ctx, &req)
 	if err != nil {
-		a.logger.Error("delete admin error", zap.Error(err))
+		a.log(ctx).Error("delete admin error", zap.Error(err))
 		return nil, err
 	}
 
@@ -295,3 +294,282 @@ func (a adminRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTo
 
 	return resp, nil
 }
+
+func (a adminRPC) RestoreAdmin(ctx context.Context, req *pb.RestoreAdminReq) (*pb.Admin, error) {
+
+	if err := a.admin.Restore(ctx, req.AdminId); err != nil {
+		a.log(ctx).Error("restore admin error", zap.Error(err))
+		return nil, err
+	}
+
+	return a.Get(ctx, &pb.GetAdminReqById{AdminId: req.AdminId})
+}
+
+func (a adminRPC) ListDeleted(ctx context.Context, req *pb.ListDeletedAdminsReq) (*pb.ListAdminsResp, error) {
+
+	resp, err := a.admin.ListDeleted(ctx, req.Limit, req.Offset, req.Filter)
+
+	if err != nil {
+		a.log(ctx).Error("list deleted admins error", zap.Error(err))
+		return nil, err
+	}
+
+	var admins pb.ListAdminsResp
+
+	for _, in := range resp {
+		admins.Admins = append(admins.Admins, &pb.Admin{
+			Id:            in.Id,
+			AdminOrder:    in.AdminOrder,
+			Role:          in.Role,
+			FirstName:     in.FirstName,
+			LastName:      in.LastName,
+			BirthDate:     in.BirthDate,
+			PhoneNumber:   in.PhoneNumber,
+			Email:         in.Email,
+			Gender:        in.Gender,
+			Salary:        in.Salary,
+			Biography:     in.Biography,
+			StartWorkYear: in.StartWorkYear,
+			EndWorkYear:   in.EndWorkYear,
+			WorkYears:     in.WorkYears,
+			Version:       in.Version,
+			CreatedAt:     in.CreatedAt.String(),
+			UpdatedAt:     in.UpdatedAt.String(),
+			TerminatedAt:  formatTime(in.TerminatedAt),
+			DeletedAt:     in.DeletedAt.String(),
+		})
+	}
+
+	return &admins, nil
+}
+
+func (a adminRPC) ListAuditLog(ctx context.Context, req *pb.ListAuditLogReq) (*pb.ListAuditLogResp, error) {
+
+	resp, err := a.admin.ListAuditLog(ctx, req.Limit, req.Offset, req.Filter)
+
+	if err != nil {
+		a.log(ctx).Error("list audit log error", zap.Error(err))
+		return nil, err
+	}
+
+	var entries pb.ListAuditLogResp
+
+	for _, in := range resp {
+		changes := make(map[string]*pb.AuditChange, len(in.Changes))
+		for field, change := range in.Changes {
+			changes[field] = &pb.AuditChange{
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+
+		entries.Entries = append(entries.Entries, &pb.AuditEntry{
+			Id:         in.Id,
+			EntityType: in.EntityType,
+			EntityId:   in.EntityId,
+			Action:     in.Action,
+			ActorId:    in.ActorId,
+			ActorType:  in.ActorType,
+			RequestId:  in.RequestId,
+			Ip:         in.IP,
+			Changes:    changes,
+			CreatedAt:  in.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &entries, nil
+}
+
+func inviteToPb(invite *entity.AdminInvite) *pb.AdminInvite {
+	resp := &pb.AdminInvite{
+		Id:        invite.Id,
+		AdminId:   invite.AdminId,
+		Channel:   invite.Channel,
+		Recipient: invite.Recipient,
+		InvitedBy: invite.InvitedBy,
+		Status:    invite.Status(time.Now()),
+		ExpiresAt: invite.ExpiresAt.Format(time.RFC3339),
+		CreatedAt: invite.CreatedAt.Format(time.RFC3339),
+	}
+	if !invite.AcceptedAt.IsZero() {
+		resp.AcceptedAt = invite.AcceptedAt.Format(time.RFC3339)
+	}
+	if !invite.RevokedAt.IsZero() {
+		resp.RevokedAt = invite.RevokedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func (a adminRPC) InviteAdmin(ctx context.Context, req *pb.InviteAdminReq) (*pb.InviteAdminResp, error) {
+
+	admin := req.GetAdmin()
+	if admin == nil {
+		admin = &pb.Admin{}
+	}
+	invited := entity.Admin{
+		Id:            admin.Id,
+		Role:          admin.Role,
+		FirstName:     admin.FirstName,
+		LastName:      admin.LastName,
+		BirthDate:     admin.BirthDate,
+		PhoneNumber:   admin.PhoneNumber,
+		Email:         admin.Email,
+		Gender:        admin.Gender,
+		Salary:        admin.Salary,
+		Biography:     admin.Biography,
+		StartWorkYear: admin.StartWorkYear,
+		EndWorkYear:   admin.EndWorkYear,
+		WorkYears:     admin.WorkYears,
+		CreatedAt:     time.Now(),
+	}
+	invite, token, err := a.invite.Invite(ctx, &invited, req.Channel)
+	if err != nil {
+		a.log(ctx).Error("invite admin error", zap.Error(err))
+		return nil, err
+	}
+	if !invite.Notified {
+		a.log(ctx).Warn("admin invite was not delivered, the token is returned to the inviter",
+			zap.String("invite_id", invite.Id))
+	}
+
+	return &pb.InviteAdminResp{
+		Invite:   inviteToPb(invite),
+		Notified: invite.Notified,
+		Token:    token,
+	}, nil
+}
+
+func (a adminRPC) AcceptInvite(ctx context.Context, req *pb.AcceptInviteReq) (*pb.Admin, error) {
+
+	adminId, err := a.invite.Accept(ctx, req.Token, req.Password)
+	if err != nil {
+		a.log(ctx).Error("accept invite error", zap.Error(err))
+		return nil, err
+	}
+
+	return a.Get(ctx, &pb.GetAdminReqById{AdminId: adminId})
+}
+
+func (a adminRPC) ListInvites(ctx context.Context, req *pb.ListInvitesReq) (*pb.ListInvitesResp, error) {
+
+	resp, err := a.invite.List(ctx, req.Limit, req.Offset, req.Filter)
+	if err != nil {
+		a.log(ctx).Error("list invites error", zap.Error(err))
+		return nil, err
+	}
+
+	var invites pb.ListInvitesResp
+	for _, in := range resp {
+		invites.Invites = append(invites.Invites, inviteToPb(in))
+	}
+
+	return &invites, nil
+}
+
+func (a adminRPC) RevokeInvite(ctx context.Context, req *pb.RevokeInviteReq) (*emptypb.Empty, error) {
+
+	if err := a.invite.Revoke(ctx, req.InviteId); err != nil {
+		a.log(ctx).Error("revoke invite error", zap.Error(err))
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// formatTime leaves a time that was never set empty
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func (a adminRPC) TerminateAdmin(ctx context.Context, req *pb.TerminateAdminReq) (*pb.Admin, error) {
+
+	if _, err := a.admin.Terminate(ctx, req.AdminId, req.EndWorkYear); err != nil {
+		a.log(ctx).Error("terminate admin error", zap.Error(err))
+		return nil, err
+	}
+
+	return a.Get(ctx, &pb.GetAdminReqById{AdminId: req.AdminId})
+}
+
+func (a adminRPC) ListSalaryHistory(ctx context.Context, req *pb.ListSalaryHistoryReq) (*pb.ListSalaryHistoryResp, error) {
+
+	resp, err := a.admin.ListSalaryHistory(ctx, req.AdminId)
+	if err != nil {
+		a.log(ctx).Error("list salary history error", zap.Error(err))
+		return nil, err
+	}
+
+	var history pb.ListSalaryHistoryResp
+	for _, in := range resp {
+		history.Changes = append(history.Changes, &pb.SalaryChange{
+			Id:        in.Id,
+			AdminId:   in.AdminId,
+			Salary:    in.Salary,
+			CreatedAt: in.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	history.Count = uint64(len(history.Changes))
+
+	return &history, nil
+}
+
+func (a adminRPC) ExportAdmins(req *pb.ExportAdminsReq, stream pb.AdminService_ExportAdminsServer) error {
+
+	ctx := stream.Context()
+	w := bufio.NewWriterSize(exportChunks(func(data []byte) error {
+		return stream.Send(&pb.ExportAdminsChunk{Data: data})
+	}), exportChunkBytes)
+
+	err := a.export.ExportAdmins(ctx, &entity.Export{
+		Format:  req.Format,
+		Columns: req.Columns,
+		Filter:  req.Filter,
+	}, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		a.log(ctx).Error("export admins error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (a adminRPC) BatchGetAdmins(ctx context.Context, req *pb.BatchGetAdminsReq) (*pb.BatchGetAdminsResp, error) {
+
+	admins, missing, err := a.admin.BatchGet(ctx, req.Ids)
+	if err != nil {
+		a.log(ctx).Error("batch get admins error", zap.Error(err))
+		return nil, err
+	}
+
+	resp := pb.BatchGetAdminsResp{MissingIds: missing}
+	for _, in := range admins {
+		resp.Admins = append(resp.Admins, &pb.Admin{
+			Id:            in.Id,
+			AdminOrder:    in.AdminOrder,
+			Role:          in.Role,
+			FirstName:     in.FirstName,
+			LastName:      in.LastName,
+			BirthDate:     in.BirthDate,
+			PhoneNumber:   in.PhoneNumber,
+			Email:         in.Email,
+			Gender:        in.Gender,
+			Salary:        in.Salary,
+			Biography:     in.Biography,
+			StartWorkYear: in.StartWorkYear,
+			EndWorkYear:   in.EndWorkYear,
+			WorkYears:     in.WorkYears,
+			Version:       in.Version,
+			CreatedAt:     in.CreatedAt.String(),
+			UpdatedAt:     in.UpdatedAt.String(),
+			TerminatedAt:  formatTime(in.TerminatedAt),
+		})
+	}
+
+	return &resp, nil
+}
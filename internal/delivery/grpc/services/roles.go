@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	pb "dennic_user_service/genproto/user_service"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/logger"
+	"dennic_user_service/internal/usecase"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type roleRPC struct {
+	logger *zap.Logger
+	role   usecase.RoleStorageI
+}
+
+func NewRoleRPC(logger *zap.Logger, role usecase.RoleStorageI) pb.RoleServiceServer {
+	return &roleRPC{
+		logger: logger,
+		role:   role,
+	}
+}
+
+// log returns the rpc logger annotated with the trace of the current request
+func (r roleRPC) log(ctx context.Context) *zap.Logger {
+	return logger.WithTrace(ctx, r.logger)
+}
+
+func roleToPb(role *entity.Role) *pb.Role {
+	resp := &pb.Role{
+		Id:          role.Id,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		BuiltIn:     role.BuiltIn,
+		CreatedAt:   role.CreatedAt.Format(time.RFC3339),
+	}
+	if !role.UpdatedAt.IsZero() {
+		resp.UpdatedAt = role.UpdatedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func (r roleRPC) CreateRole(ctx context.Context, role *pb.Role) (*pb.Role, error) {
+
+	req := entity.Role{
+		Id:          role.Id,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+	id, err := r.role.Create(ctx, &req)
+	if err != nil {
+		r.log(ctx).Error("create role error", zap.Error(err))
+		return nil, err
+	}
+
+	return r.GetRole(ctx, &pb.GetRoleReq{RoleId: id})
+}
+
+func (r roleRPC) GetRole(ctx context.Context, req *pb.GetRoleReq) (*pb.Role, error) {
+
+	resp, err := r.role.Get(ctx, req.RoleId)
+	if err != nil {
+		r.log(ctx).Error("get role error", zap.Error(err))
+		return nil, err
+	}
+
+	return roleToPb(resp), nil
+}
+
+func (r roleRPC) ListRoles(ctx context.Context, req *pb.ListRolesReq) (*pb.ListRolesResp, error) {
+
+	resp, err := r.role.List(ctx, req.Limit, req.Offset)
+	if err != nil {
+		r.log(ctx).Error("list roles error", zap.Error(err))
+		return nil, err
+	}
+
+	var roles pb.ListRolesResp
+	for _, in := range resp {
+		roles.Roles = append(roles.Roles, roleToPb(in))
+	}
+
+	return &roles, nil
+}
+
+func (r roleRPC) UpdateRole(ctx context.Context, role *pb.Role) (*pb.Role, error) {
+
+	req := entity.Role{
+		Id:          role.Id,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+	}
+	if err := r.role.Update(ctx, &req); err != nil {
+		r.log(ctx).Error("update role error", zap.Error(err))
+		return nil, err
+	}
+
+	return r.GetRole(ctx, &pb.GetRoleReq{RoleId: role.Id})
+}
+
+func (r roleRPC) DeleteRole(ctx context.Context, req *pb.DeleteRoleReq) (*emptypb.Empty, error) {
+
+	if err := r.role.Delete(ctx, req.RoleId); err != nil {
+		r.log(ctx).Error("delete role error", zap.Error(err))
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (r roleRPC) AssignRole(ctx context.Context, req *pb.AssignRoleReq) (*emptypb.Empty, error) {
+
+	if err := r.role.Assign(ctx, req.AdminId, req.RoleId); err != nil {
+		r.log(ctx).Error("assign role error", zap.Error(err))
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (r roleRPC) RevokeRole(ctx context.Context, req *pb.RevokeRoleReq) (*emptypb.Empty, error) {
+
+	if err := r.role.Revoke(ctx, req.AdminId, req.RoleId); err != nil {
+		r.log(ctx).Error("revoke role error", zap.Error(err))
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (r roleRPC) ListAdminRoles(ctx context.Context, req *pb.ListAdminRolesReq) (*pb.ListRolesResp, error) {
+
+	resp, err := r.role.ListAdminRoles(ctx, req.AdminId)
+	if err != nil {
+		r.log(ctx).Error("list admin roles error", zap.Error(err))
+		return nil, err
+	}
+
+	var roles pb.ListRolesResp
+	for _, in := range resp {
+		roles.Roles = append(roles.Roles, roleToPb(in))
+	}
+
+	return &roles, nil
+}
+
+func (r roleRPC) ListRoleAssignments(ctx context.Context, req *pb.ListRoleAssignmentsReq) (*pb.ListRoleAssignmentsResp, error) {
+
+	resp, err := r.role.ListAssignmentHistory(ctx, req.Limit, req.Offset, req.Filter)
+	if err != nil {
+		r.log(ctx).Error("list role assignments error", zap.Error(err))
+		return nil, err
+	}
+
+	var assignments pb.ListRoleAssignmentsResp
+	for _, in := range resp {
+		assignments.Assignments = append(assignments.Assignments, &pb.RoleAssignment{
+			Id:        in.Id,
+			AdminId:   in.AdminId,
+			RoleId:    in.RoleId,
+			RoleName:  in.RoleName,
+			Action:    in.Action,
+			ActorId:   in.ActorId,
+			CreatedAt: in.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &assignments, nil
+}
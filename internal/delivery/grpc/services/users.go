@@ -1,11 +1,19 @@
 package services
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	pb "dennic_user_service/genproto/user_service"
 	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/logger"
+	"dennic_user_service/internal/pkg/reqinfo"
 	"dennic_user_service/internal/usecase"
 	"dennic_user_service/internal/usecase/event"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,58 +21,100 @@ import (
 )
 
 type userRPC struct {
-	logger         *zap.Logger
-	user           usecase.UserStorageI
-	brokerProducer event.BrokerProducer
+	logger            *zap.Logger
+	user              usecase.UserStorageI
+	emailVerification usecase.EmailVerificationStorageI
+	medical           usecase.MedicalStorageI
+	consent           usecase.ConsentStorageI
+	export            usecase.ExportStorageI
+	merge             usecase.MergeStorageI
+	brokerProducer    event.BrokerProducer
 }
 
-func NewUserRPC(logger *zap.Logger, user usecase.UserStorageI,
-	brokerProducer event.BrokerProducer) pb.UserServiceServer {
+func NewUserRPC(logger *zap.Logger, user usecase.UserStorageI, emailVerification usecase.EmailVerificationStorageI,
+	medical usecase.MedicalStorageI, consent usecase.ConsentStorageI, export usecase.ExportStorageI,
+	merge usecase.MergeStorageI, brokerProducer event.BrokerProducer) pb.UserServiceServer {
 	return &userRPC{
-		logger:         logger,
-		user:           user,
-		brokerProducer: brokerProducer,
+		logger:            logger,
+		user:              user,
+		emailVerification: emailVerification,
+		medical:           medical,
+		consent:           consent,
+		export:            export,
+		merge:             merge,
+		brokerProducer:    brokerProducer,
 	}
 }
 
+// log returns the rpc logger annotated with the trace of the current request
+func (u userRPC) log(ctx context.Context) *zap.Logger {
+	return logger.WithTrace(ctx, u.logger)
+}
+
 func (u userRPC) Create(ctx context.Context, user *pb.User) (*pb.User, error) {
 
 	req := entity.User{
-		Id:           user.Id,
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		BirthDate:    user.BirthDate,
-		PhoneNumber:  user.PhoneNumber,
-		Password:     user.Password,
-		Gender:       user.Gender,
-		RefreshToken: user.RefreshToken,
-		CreatedAt:    time.Now(),
+		Id:                   user.Id,
+		FirstName:            user.FirstName,
+		LastName:             user.LastName,
+		BirthDate:            user.BirthDate,
+		PhoneNumber:          user.PhoneNumber,
+		Password:             user.Password,
+		Gender:               user.Gender,
+		RefreshToken:         user.RefreshToken,
+		Status:               user.Status,
+		Email:                user.Email,
+		PreferredLanguage:    user.PreferredLanguage,
+		AddressLine:          user.AddressLine,
+		City:                 user.City,
+		Country:              user.Country,
+		AvatarKey:            user.AvatarKey,
+		CreatedAt:            time.Now(),
+		AcceptedTermsVersion: user.AcceptedTermsVersion,
 	}
 	UserId, err := u.user.Create(ctx, &req)
 	if err != nil {
-		u.logger.Error("Create user error", zap.Error(err))
+		u.log(ctx).Error("Create user error", zap.Error(err))
 		return nil, err
 	}
 
+	// a self-signup has no actor, the new user reads back its own account
+	readCtx := ctx
+	if info := reqinfo.From(ctx); info.ActorType == "" {
+		info.ActorId, info.ActorType = UserId, entity.ActorTypeUser
+		readCtx = reqinfo.With(ctx, info)
+	}
+
 	Params := make(map[string]string)
 	Params["id"] = UserId
-	resp, err := u.user.Get(ctx, Params)
+	resp, err := u.user.Get(readCtx, Params)
 	if err != nil {
-		u.logger.Error("Create user error", zap.Error(err))
+		u.log(ctx).Error("Create user error", zap.Error(err))
 		return nil, err
 	}
 
 	return &pb.User{
-		Id:           resp.Id,
-		UserOrder:    resp.UserOrder,
-		FirstName:    resp.FirstName,
-		LastName:     resp.LastName,
-		BirthDate:    resp.BirthDate,
-		PhoneNumber:  resp.PhoneNumber,
-		Password:     resp.Password,
-		Gender:       resp.Gender,
-		RefreshToken: resp.RefreshToken,
-		CreatedAt:    resp.CreatedAt.String(),
+		Id:                resp.Id,
+		UserOrder:         resp.UserOrder,
+		FirstName:         resp.FirstName,
+		LastName:          resp.LastName,
+		BirthDate:         resp.BirthDate,
+		PhoneNumber:       resp.PhoneNumber,
+		Password:          resp.Password,
+		Gender:            resp.Gender,
+		RefreshToken:      resp.RefreshToken,
+		Version:           resp.Version,
+		CreatedAt:         resp.CreatedAt.String(),
+		Status:            resp.Status,
+		StatusReason:      resp.StatusReason,
+		StatusExpiresAt:   formatTime(resp.StatusExpiresAt),
+		Email:             resp.Email,
+		EmailVerifiedAt:   formatTime(resp.EmailVerifiedAt),
+		PreferredLanguage: resp.PreferredLanguage,
+		AddressLine:       resp.AddressLine,
+		City:              resp.City,
+		Country:           resp.Country,
+		AvatarKey:         resp.AvatarKey,
 	}, nil
 }
 
@@ -76,22 +126,34 @@ func (u userRPC) Get(ctx context.Context, id *pb.GetUserReqById) (*pb.User, erro
 	resp, err := u.user.Get(ctx, reqMap)
 
 	if err != nil {
-		u.logger.Error("get user error", zap.Error(err))
+		u.log(ctx).Error("get user error", zap.Error(err))
 		return nil, err
 	}
 
 	return &pb.User{
-		Id:           resp.Id,
-		UserOrder:    resp.UserOrder,
-		FirstName:    resp.FirstName,
-		LastName:     resp.LastName,
-		BirthDate:    resp.BirthDate,
-		PhoneNumber:  resp.PhoneNumber,
-		Password:     resp.Password,
-		Gender:       resp.Gender,
-		RefreshToken: resp.RefreshToken,
-		CreatedAt:    resp.CreatedAt.String(),
-		UpdatedAt:    resp.UpdatedAt.String(),
+		Id:                resp.Id,
+		UserOrder:         resp.UserOrder,
+		FirstName:         resp.FirstName,
+		LastName:          resp.LastName,
+		BirthDate:         resp.BirthDate,
+		PhoneNumber:       resp.PhoneNumber,
+		Password:          resp.Password,
+		Gender:            resp.Gender,
+		RefreshToken:      resp.RefreshToken,
+		Version:           resp.Version,
+		CreatedAt:         resp.CreatedAt.String(),
+		UpdatedAt:         resp.UpdatedAt.String(),
+		Status:            resp.Status,
+		StatusReason:      resp.StatusReason,
+		StatusExpiresAt:   formatTime(resp.StatusExpiresAt),
+		Email:             resp.Email,
+		EmailVerifiedAt:   formatTime(resp.EmailVerifiedAt),
+		PreferredLanguage: resp.PreferredLanguage,
+		AddressLine:       resp.AddressLine,
+		City:              resp.City,
+		Country:           resp.Country,
+		AvatarKey:         resp.AvatarKey,
+		EmergencyContacts: emergencyContactsToPb(resp.EmergencyContacts),
 	}, nil
 }
 
@@ -100,7 +162,7 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 	resp, err := u.user.List(ctx, req.Limit, req.Offset, req.Filter)
 
 	if err != nil {
-		u.logger.Error("get all user error", zap.Error(err))
+		u.log(ctx).Error("get all user error", zap.Error(err))
 		return nil, err
 	}
 
@@ -108,17 +170,28 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 
 	for _, in := range resp {
 		users.Users = append(users.Users, &pb.User{
-			Id:           in.Id,
-			UserOrder:    in.UserOrder,
-			FirstName:    in.FirstName,
-			LastName:     in.LastName,
-			BirthDate:    in.BirthDate,
-			PhoneNumber:  in.PhoneNumber,
-			Password:     in.Password,
-			Gender:       in.Gender,
-			RefreshToken: in.RefreshToken,
-			CreatedAt:    in.CreatedAt.String(),
-			UpdatedAt:    in.UpdatedAt.String(),
+			Id:                in.Id,
+			UserOrder:         in.UserOrder,
+			FirstName:         in.FirstName,
+			LastName:          in.LastName,
+			BirthDate:         in.BirthDate,
+			PhoneNumber:       in.PhoneNumber,
+			Password:          in.Password,
+			Gender:            in.Gender,
+			RefreshToken:      in.RefreshToken,
+			Version:           in.Version,
+			CreatedAt:         in.CreatedAt.String(),
+			UpdatedAt:         in.UpdatedAt.String(),
+			Status:            in.Status,
+			StatusReason:      in.StatusReason,
+			StatusExpiresAt:   formatTime(in.StatusExpiresAt),
+			Email:             in.Email,
+			EmailVerifiedAt:   formatTime(in.EmailVerifiedAt),
+			PreferredLanguage: in.PreferredLanguage,
+			AddressLine:       in.AddressLine,
+			City:              in.City,
+			Country:           in.Country,
+			AvatarKey:         in.AvatarKey,
 		})
 	}
 
@@ -128,37 +201,33 @@ func (u userRPC) ListUsers(ctx context.Context, req *pb.ListUsersReq) (*pb.ListU
 func (u userRPC) Update(ctx context.Context, user *pb.User) (*pb.User, error) {
 
 	req := entity.User{
-		Id:           user.Id,
-		UserOrder:    user.UserOrder,
-		FirstName:    user.FirstName,
-		LastName:     user.LastName,
-		BirthDate:    user.BirthDate,
-		PhoneNumber:  user.PhoneNumber,
-		Password:     user.Password,
-		Gender:       user.Gender,
-		RefreshToken: user.RefreshToken,
+		Id:                user.Id,
+		UserOrder:         user.UserOrder,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		BirthDate:         user.BirthDate,
+		PhoneNumber:       user.PhoneNumber,
+		Password:          user.Password,
+		Gender:            user.Gender,
+		RefreshToken:      user.RefreshToken,
+		Email:             user.Email,
+		PreferredLanguage: user.PreferredLanguage,
+		AddressLine:       user.AddressLine,
+		City:              user.City,
+		Country:           user.Country,
+		AvatarKey:         user.AvatarKey,
+		Version:           user.Version,
 	}
 
-	err := u.user.Update(ctx, &req)
+	err := u.user.Update(ctx, &req, user.GetUpdateMask().GetPaths())
 
 	if err != nil {
-		u.logger.Error("update user error", zap.Error(err))
+		u.log(ctx).Error("update user error", zap.Error(err))
 		return nil, err
 	}
 
-	return &pb.User{
-		Id:           req.Id,
-		UserOrder:    req.UserOrder,
-		FirstName:    req.FirstName,
-		LastName:     req.LastName,
-		BirthDate:    req.BirthDate,
-		PhoneNumber:  req.PhoneNumber,
-		Password:     req.Password,
-		Gender:       req.Gender,
-		RefreshToken: req.RefreshToken,
-		CreatedAt:    req.CreatedAt.String(),
-		UpdatedAt:    req.UpdatedAt.String(),
-	}, nil
+	// fields outside the mask are kept as stored, so read the user back
+	return u.Get(ctx, &pb.GetUserReqById{UserId: req.Id})
 }
 
 func (u userRPC) Delete(ctx context.Context, id *pb.DeleteUserReq) (resp *emptypb.Empty, err error) {
@@ -168,7 +237,7 @@ func (u userRPC) Delete(ctx context.Context, id *pb.DeleteUserReq) (resp *emptyp
 
 	err = u.user.Delete(ctx, id.UserId)
 	if err != nil {
-		u.logger.Error("delete user error", zap.Error(err))
+		u.log(ctx).Error("delete user error", zap.Error(err))
 		return nil, err
 	}
 
@@ -184,7 +253,7 @@ func (u userRPC) CheckField(ctx context.Context, req *pb.CheckFieldUserReq) (*pb
 
 	resp, err := u.user.CheckField(ctx, &reqUser)
 	if err != nil {
-		u.logger.Error("delete user error", zap.Error(err))
+		u.log(ctx).Error("delete user error", zap.Error(err))
 		return nil, err
 	}
 	response := &pb.CheckFieldUserResp{
@@ -203,7 +272,7 @@ func (u userRPC) IfExists(ctx context.Context, phone *pb.IfUserExistsReq) (resp
 	entityResp, err := u.user.IfExists(ctx, &req)
 
 	if err != nil {
-		u.logger.Error("delete user error", zap.Error(err))
+		u.log(ctx).Error("delete user error", zap.Error(err))
 		return nil, err
 	}
 
@@ -222,7 +291,7 @@ func (u userRPC) ChangePassword(ctx context.Context, phone *pb.ChangeUserPasswor
 	}
 	status, err := u.user.ChangePassword(ctx, &req)
 	if err != nil {
-		u.logger.Error("delete user error", zap.Error(err))
+		u.log(ctx).Error("delete user error", zap.Error(err))
 		return nil, err
 	}
 	resp = &pb.ChangeUserPasswordResp{
@@ -239,7 +308,7 @@ func (u userRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTok
 	}
 	status, err := u.user.UpdateRefreshToken(ctx, &req)
 	if err != nil {
-		u.logger.Error("delete user error", zap.Error(err))
+		u.log(ctx).Error("delete user error", zap.Error(err))
 		return nil, err
 	}
 
@@ -249,3 +318,658 @@ func (u userRPC) UpdateRefreshToken(ctx context.Context, id *pb.UpdateRefreshTok
 
 	return resp, nil
 }
+
+func (u userRPC) RestoreUser(ctx context.Context, req *pb.RestoreUserReq) (*pb.User, error) {
+
+	if err := u.user.Restore(ctx, req.UserId); err != nil {
+		u.log(ctx).Error("restore user error", zap.Error(err))
+		return nil, err
+	}
+
+	return u.Get(ctx, &pb.GetUserReqById{UserId: req.UserId})
+}
+
+func (u userRPC) ListDeleted(ctx context.Context, req *pb.ListDeletedUsersReq) (*pb.ListUsersResp, error) {
+
+	resp, err := u.user.ListDeleted(ctx, req.Limit, req.Offset, req.Filter)
+
+	if err != nil {
+		u.log(ctx).Error("list deleted users error", zap.Error(err))
+		return nil, err
+	}
+
+	var users pb.ListUsersResp
+
+	for _, in := range resp {
+		users.Users = append(users.Users, &pb.User{
+			Id:                in.Id,
+			UserOrder:         in.UserOrder,
+			FirstName:         in.FirstName,
+			LastName:          in.LastName,
+			BirthDate:         in.BirthDate,
+			PhoneNumber:       in.PhoneNumber,
+			Gender:            in.Gender,
+			Version:           in.Version,
+			CreatedAt:         in.CreatedAt.String(),
+			UpdatedAt:         in.UpdatedAt.String(),
+			DeletedAt:         in.DeletedAt.String(),
+			Status:            in.Status,
+			StatusReason:      in.StatusReason,
+			StatusExpiresAt:   formatTime(in.StatusExpiresAt),
+			Email:             in.Email,
+			EmailVerifiedAt:   formatTime(in.EmailVerifiedAt),
+			PreferredLanguage: in.PreferredLanguage,
+			AddressLine:       in.AddressLine,
+			City:              in.City,
+			Country:           in.Country,
+			AvatarKey:         in.AvatarKey,
+		})
+	}
+
+	return &users, nil
+}
+
+func (u userRPC) ExportUserData(ctx context.Context, req *pb.ExportUserDataReq) (*pb.ExportUserDataResp, error) {
+
+	export, err := u.user.ExportData(ctx, req.UserId)
+	if err != nil {
+		u.log(ctx).Error("export user data error", zap.Error(err))
+		return nil, err
+	}
+
+	data, err := json.Marshal(export)
+	if err != nil {
+		u.log(ctx).Error("export user data error", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.ExportUserDataResp{
+		UserId:      req.UserId,
+		ContentType: "application/json",
+		Data:        data,
+		GeneratedAt: export.GeneratedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (u userRPC) EraseUser(ctx context.Context, req *pb.EraseUserReq) (*pb.EraseUserResp, error) {
+
+	erased, err := u.user.Erase(ctx, req.UserId)
+	if err != nil {
+		u.log(ctx).Error("erase user error", zap.Error(err))
+		return nil, err
+	}
+
+	// other services keep their copies until they get the event, the client repeats the erasure
+	if err := u.brokerProducer.ProduceUserErased(ctx, erased); err != nil {
+		u.log(ctx).Error("produce user erased event error", zap.String("user_id", erased.UserId), zap.Error(err))
+		return nil, entity.NewErrEventNotPublished("user.erased", err)
+	}
+
+	return &pb.EraseUserResp{
+		UserId:   erased.UserId,
+		ErasedAt: erased.ErasedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (u userRPC) SuspendUser(ctx context.Context, req *pb.SuspendUserReq) (*pb.User, error) {
+
+	return u.changeStatus(ctx, req.UserId, entity.UserStatusSuspended, req.Reason, req.ExpiresAt)
+}
+
+func (u userRPC) ActivateUser(ctx context.Context, req *pb.ActivateUserReq) (*pb.User, error) {
+
+	return u.changeStatus(ctx, req.UserId, entity.UserStatusActive, "", "")
+}
+
+func (u userRPC) BlockUser(ctx context.Context, req *pb.BlockUserReq) (*pb.User, error) {
+
+	return u.changeStatus(ctx, req.UserId, entity.UserStatusBlocked, req.Reason, req.ExpiresAt)
+}
+
+func (u userRPC) changeStatus(ctx context.Context, id, status, reason, expiresAt string) (*pb.User, error) {
+	var expires time.Time
+	if expiresAt != "" {
+		var err error
+		if expires, err = time.Parse(time.RFC3339, expiresAt); err != nil {
+			errValidation := entity.NewErrValidation()
+			errValidation.Err = err
+			errValidation.Errors["expires_at"] = "expires_at must be formatted as RFC3339"
+			return nil, errValidation
+		}
+	}
+
+	if _, err := u.user.ChangeStatus(ctx, id, status, reason, expires); err != nil {
+		u.log(ctx).Error("change user status error", zap.String("status", status), zap.Error(err))
+		return nil, err
+	}
+
+	return u.Get(ctx, &pb.GetUserReqById{UserId: id})
+}
+
+func (u userRPC) AddEmergencyContact(ctx context.Context, req *pb.EmergencyContact) (*pb.EmergencyContact, error) {
+
+	contact := emergencyContactFromPb(req)
+	if err := u.user.AddEmergencyContact(ctx, contact); err != nil {
+		u.log(ctx).Error("add emergency contact error", zap.Error(err))
+		return nil, err
+	}
+
+	return emergencyContactToPb(contact), nil
+}
+
+func (u userRPC) ListEmergencyContacts(ctx context.Context, req *pb.ListEmergencyContactsReq) (*pb.ListEmergencyContactsResp, error) {
+
+	contacts, err := u.user.ListEmergencyContacts(ctx, req.UserId)
+	if err != nil {
+		u.log(ctx).Error("list emergency contacts error", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.ListEmergencyContactsResp{EmergencyContacts: emergencyContactsToPb(contacts)}, nil
+}
+
+func (u userRPC) UpdateEmergencyContact(ctx context.Context, req *pb.EmergencyContact) (*pb.EmergencyContact, error) {
+
+	contact := emergencyContactFromPb(req)
+	if err := u.user.UpdateEmergencyContact(ctx, contact); err != nil {
+		u.log(ctx).Error("update emergency contact error", zap.Error(err))
+		return nil, err
+	}
+
+	return emergencyContactToPb(contact), nil
+}
+
+func (u userRPC) DeleteEmergencyContact(ctx context.Context, req *pb.DeleteEmergencyContactReq) (*emptypb.Empty, error) {
+
+	if err := u.user.DeleteEmergencyContact(ctx, req.UserId, req.Id); err != nil {
+		u.log(ctx).Error("delete emergency contact error", zap.Error(err))
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (u userRPC) RequestEmailVerification(ctx context.Context, req *pb.RequestEmailVerificationReq) (*pb.RequestEmailVerificationResp, error) {
+
+	verification, err := u.emailVerification.Request(ctx, req.UserId)
+	if err != nil {
+		u.log(ctx).Error("request email verification error", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.RequestEmailVerificationResp{
+		Email:     verification.Email,
+		ExpiresAt: formatTime(verification.ExpiresAt),
+	}, nil
+}
+
+func (u userRPC) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReq) (*pb.User, error) {
+
+	userId, err := u.emailVerification.Verify(ctx, req.Token)
+	if err != nil {
+		u.log(ctx).Error("verify email error", zap.Error(err))
+		return nil, err
+	}
+
+	return u.Get(ctx, &pb.GetUserReqById{UserId: userId})
+}
+
+func emergencyContactFromPb(contact *pb.EmergencyContact) *entity.EmergencyContact {
+	return &entity.EmergencyContact{
+		Id:           contact.Id,
+		UserId:       contact.UserId,
+		FullName:     contact.FullName,
+		Relationship: contact.Relationship,
+		PhoneNumber:  contact.PhoneNumber,
+	}
+}
+
+func emergencyContactToPb(contact *entity.EmergencyContact) *pb.EmergencyContact {
+	return &pb.EmergencyContact{
+		Id:           contact.Id,
+		UserId:       contact.UserId,
+		FullName:     contact.FullName,
+		Relationship: contact.Relationship,
+		PhoneNumber:  contact.PhoneNumber,
+		CreatedAt:    formatTime(contact.CreatedAt),
+		UpdatedAt:    formatTime(contact.UpdatedAt),
+	}
+}
+
+func emergencyContactsToPb(contacts []*entity.EmergencyContact) []*pb.EmergencyContact {
+	var resp []*pb.EmergencyContact
+	for _, contact := range contacts {
+		resp = append(resp, emergencyContactToPb(contact))
+	}
+	return resp
+}
+
+func (u userRPC) AddDependant(ctx context.Context, req *pb.AddDependantReq) (*pb.Dependant, error) {
+
+	dependant := entity.Dependant{
+		GuardianId:   req.GuardianId,
+		Relationship: req.Relationship,
+	}
+	if user := req.GetUser(); user != nil {
+		dependant.User = &entity.User{
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			BirthDate:         user.BirthDate,
+			Gender:            user.Gender,
+			Email:             user.Email,
+			PreferredLanguage: user.PreferredLanguage,
+			AddressLine:       user.AddressLine,
+			City:              user.City,
+			Country:           user.Country,
+			AvatarKey:         user.AvatarKey,
+		}
+	}
+	if err := u.user.AddDependant(ctx, &dependant); err != nil {
+		u.log(ctx).Error("add dependant error", zap.Error(err))
+		return nil, err
+	}
+
+	return dependantToPb(&dependant), nil
+}
+
+func (u userRPC) ListDependants(ctx context.Context, req *pb.ListDependantsReq) (*pb.ListDependantsResp, error) {
+
+	dependants, err := u.user.ListDependants(ctx, req.GuardianId)
+	if err != nil {
+		u.log(ctx).Error("list dependants error", zap.Error(err))
+		return nil, err
+	}
+
+	var resp pb.ListDependantsResp
+	for _, dependant := range dependants {
+		resp.Dependants = append(resp.Dependants, dependantToPb(dependant))
+	}
+
+	return &resp, nil
+}
+
+func (u userRPC) TransferDependant(ctx context.Context, req *pb.TransferDependantReq) (*pb.Dependant, error) {
+
+	dependant, err := u.user.TransferDependant(ctx, req.DependantId, req.GuardianId, req.Relationship)
+	if err != nil {
+		u.log(ctx).Error("transfer dependant error", zap.Error(err))
+		return nil, err
+	}
+
+	return dependantToPb(dependant), nil
+}
+
+func (u userRPC) CheckGuardianship(ctx context.Context, req *pb.CheckGuardianshipReq) (*pb.CheckGuardianshipResp, error) {
+
+	isGuardian, relationship, err := u.user.CheckGuardianship(ctx, req.GuardianId, req.DependantId)
+	if err != nil {
+		u.log(ctx).Error("check guardianship error", zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.CheckGuardianshipResp{
+		IsGuardian:   isGuardian,
+		Relationship: relationship,
+	}, nil
+}
+
+func dependantToPb(dependant *entity.Dependant) *pb.Dependant {
+	user := dependant.User
+	return &pb.Dependant{
+		User: &pb.User{
+			Id:                user.Id,
+			UserOrder:         user.UserOrder,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			BirthDate:         user.BirthDate,
+			Gender:            user.Gender,
+			Version:           user.Version,
+			CreatedAt:         formatTime(user.CreatedAt),
+			UpdatedAt:         formatTime(user.UpdatedAt),
+			Status:            user.Status,
+			StatusReason:      user.StatusReason,
+			StatusExpiresAt:   formatTime(user.StatusExpiresAt),
+			Email:             user.Email,
+			EmailVerifiedAt:   formatTime(user.EmailVerifiedAt),
+			PreferredLanguage: user.PreferredLanguage,
+			AddressLine:       user.AddressLine,
+			City:              user.City,
+			Country:           user.Country,
+			AvatarKey:         user.AvatarKey,
+		},
+		GuardianId:   dependant.GuardianId,
+		Relationship: dependant.Relationship,
+		CreatedAt:    formatTime(dependant.CreatedAt),
+		UpdatedAt:    formatTime(dependant.UpdatedAt),
+	}
+}
+
+func (u userRPC) GetMedicalProfile(ctx context.Context, req *pb.GetMedicalProfileReq) (*pb.MedicalProfile, error) {
+
+	profile, err := u.medical.Get(ctx, req.UserId)
+	if err != nil {
+		u.log(ctx).Error("get medical profile error", zap.Error(err))
+		return nil, err
+	}
+
+	return medicalProfileToPb(profile), nil
+}
+
+func (u userRPC) UpdateMedicalProfile(ctx context.Context, req *pb.MedicalProfile) (*pb.MedicalProfile, error) {
+
+	profile := entity.MedicalProfile{
+		UserId:            req.UserId,
+		BloodType:         req.BloodType,
+		Allergies:         req.Allergies,
+		ChronicConditions: req.ChronicConditions,
+		Medications:       req.Medications,
+		Version:           req.Version,
+	}
+	if err := u.medical.Update(ctx, &profile); err != nil {
+		u.log(ctx).Error("update medical profile error", zap.Error(err))
+		return nil, err
+	}
+
+	return medicalProfileToPb(&profile), nil
+}
+
+func medicalProfileToPb(profile *entity.MedicalProfile) *pb.MedicalProfile {
+	return &pb.MedicalProfile{
+		UserId:            profile.UserId,
+		BloodType:         profile.BloodType,
+		Allergies:         profile.Allergies,
+		ChronicConditions: profile.ChronicConditions,
+		Medications:       profile.Medications,
+		Version:           profile.Version,
+		UpdatedBy:         profile.UpdatedBy,
+		CreatedAt:         formatTime(profile.CreatedAt),
+		UpdatedAt:         formatTime(profile.UpdatedAt),
+	}
+}
+
+func (u userRPC) PublishConsentDocument(ctx context.Context, req *pb.ConsentDocument) (*pb.ConsentDocument, error) {
+
+	document := entity.ConsentDocument{
+		Type:        req.Type,
+		Url:         req.Url,
+		ContentHash: req.ContentHash,
+	}
+	if err := u.consent.PublishDocument(ctx, &document); err != nil {
+		u.log(ctx).Error("publish consent document error", zap.Error(err))
+		return nil, err
+	}
+
+	return consentDocumentToPb(&document), nil
+}
+
+func (u userRPC) GetConsentDocument(ctx context.Context, req *pb.GetConsentDocumentReq) (*pb.ConsentDocument, error) {
+
+	document, err := u.consent.GetDocument(ctx, req.Type, req.Version)
+	if err != nil {
+		u.log(ctx).Error("get consent document error", zap.Error(err))
+		return nil, err
+	}
+
+	return consentDocumentToPb(document), nil
+}
+
+func (u userRPC) RecordConsent(ctx context.Context, req *pb.Consent) (*pb.Consent, error) {
+
+	consent := entity.Consent{
+		UserId:          req.UserId,
+		DocumentType:    req.DocumentType,
+		DocumentVersion: req.DocumentVersion,
+	}
+	if err := u.consent.Record(ctx, &consent); err != nil {
+		u.log(ctx).Error("record consent error", zap.Error(err))
+		return nil, err
+	}
+
+	return consentToPb(&consent), nil
+}
+
+func (u userRPC) WithdrawConsent(ctx context.Context, req *pb.WithdrawConsentReq) (*pb.WithdrawConsentResp, error) {
+
+	withdrawn, err := u.consent.Withdraw(ctx, req.UserId, req.DocumentType)
+	if err != nil {
+		u.log(ctx).Error("withdraw consent error", zap.Error(err))
+		return nil, err
+	}
+
+	// the event is the proof other services stopped the processing, a repeated withdrawal sends it again
+	if err := u.brokerProducer.ProduceConsentWithdrawn(ctx, withdrawn); err != nil {
+		u.log(ctx).Error("produce consent withdrawn event error", zap.String("user_id", withdrawn.UserId), zap.Error(err))
+		return nil, entity.NewErrEventNotPublished("user.consent_withdrawn", err)
+	}
+
+	return &pb.WithdrawConsentResp{
+		UserId:          withdrawn.UserId,
+		DocumentType:    withdrawn.DocumentType,
+		DocumentVersion: withdrawn.DocumentVersion,
+		WithdrawnAt:     formatTime(withdrawn.WithdrawnAt),
+	}, nil
+}
+
+func (u userRPC) ListConsents(ctx context.Context, req *pb.ListConsentsReq) (*pb.ListConsentsResp, error) {
+
+	consents, err := u.consent.List(ctx, req.UserId)
+	if err != nil {
+		u.log(ctx).Error("list consents error", zap.Error(err))
+		return nil, err
+	}
+
+	var resp pb.ListConsentsResp
+	for _, consent := range consents {
+		resp.Consents = append(resp.Consents, consentToPb(consent))
+	}
+
+	return &resp, nil
+}
+
+func consentDocumentToPb(document *entity.ConsentDocument) *pb.ConsentDocument {
+	return &pb.ConsentDocument{
+		Type:        document.Type,
+		Version:     document.Version,
+		Url:         document.Url,
+		ContentHash: document.ContentHash,
+		PublishedBy: document.PublishedBy,
+		PublishedAt: formatTime(document.PublishedAt),
+	}
+}
+
+func consentToPb(consent *entity.Consent) *pb.Consent {
+	return &pb.Consent{
+		Id:              consent.Id,
+		UserId:          consent.UserId,
+		DocumentType:    consent.DocumentType,
+		DocumentVersion: consent.DocumentVersion,
+		Ip:              consent.IP,
+		AcceptedAt:      formatTime(consent.AcceptedAt),
+		WithdrawnAt:     formatTime(consent.WithdrawnAt),
+	}
+}
+
+// maxImportBytes bounds the CSV of an import, which is read whole before it is imported
+const maxImportBytes = 32 << 20
+
+func (u userRPC) ImportUsers(stream pb.UserService_ImportUsersServer) error {
+
+	ctx := stream.Context()
+	var (
+		data   bytes.Buffer
+		dryRun bool
+	)
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			u.log(ctx).Error("import users error", zap.Error(err))
+			return err
+		}
+		if first {
+			dryRun = req.DryRun
+		}
+		if data.Len()+len(req.Data) > maxImportBytes {
+			errValidation := entity.NewErrValidation()
+			errValidation.Err = fmt.Errorf("import is larger than %d bytes", maxImportBytes)
+			errValidation.Errors["data"] = fmt.Sprintf("an import may have at most %d MiB", maxImportBytes>>20)
+			return errValidation
+		}
+		data.Write(req.Data)
+	}
+
+	report, err := u.user.ImportUsers(ctx, &data, dryRun)
+	if err != nil {
+		u.log(ctx).Error("import users error", zap.Error(err))
+		return err
+	}
+
+	resp := pb.ImportUsersResp{
+		DryRun:     report.DryRun,
+		Imported:   int64(report.Imported),
+		Duplicates: int64(report.Duplicates),
+		Failed:     int64(report.Failed),
+	}
+	for _, row := range report.Rows {
+		resp.Rows = append(resp.Rows, &pb.ImportUserRow{
+			Line:        int64(row.Line),
+			Status:      row.Status,
+			UserId:      row.UserId,
+			PhoneNumber: row.PhoneNumber,
+			Errors:      row.Errors,
+		})
+	}
+
+	return stream.SendAndClose(&resp)
+}
+
+// exportChunkBytes is the size of the chunks an export is streamed in
+const exportChunkBytes = 64 << 10
+
+// exportChunks sends every write as a chunk of an export
+type exportChunks func([]byte) error
+
+func (e exportChunks) Write(p []byte) (int, error) {
+	if err := e(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (u userRPC) ExportUsers(req *pb.ExportUsersReq, stream pb.UserService_ExportUsersServer) error {
+
+	ctx := stream.Context()
+	w := bufio.NewWriterSize(exportChunks(func(data []byte) error {
+		return stream.Send(&pb.ExportUsersChunk{Data: data})
+	}), exportChunkBytes)
+
+	err := u.export.ExportUsers(ctx, &entity.Export{
+		Format:  req.Format,
+		Columns: req.Columns,
+		Filter:  req.Filter,
+	}, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		u.log(ctx).Error("export users error", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (u userRPC) BatchGetUsers(ctx context.Context, req *pb.BatchGetUsersReq) (*pb.BatchGetUsersResp, error) {
+
+	users, missing, err := u.user.BatchGet(ctx, req.Ids)
+	if err != nil {
+		u.log(ctx).Error("batch get users error", zap.Error(err))
+		return nil, err
+	}
+
+	resp := pb.BatchGetUsersResp{MissingIds: missing}
+	for _, in := range users {
+		resp.Users = append(resp.Users, &pb.User{
+			Id:                in.Id,
+			UserOrder:         in.UserOrder,
+			FirstName:         in.FirstName,
+			LastName:          in.LastName,
+			BirthDate:         in.BirthDate,
+			PhoneNumber:       in.PhoneNumber,
+			Gender:            in.Gender,
+			Version:           in.Version,
+			CreatedAt:         in.CreatedAt.String(),
+			UpdatedAt:         in.UpdatedAt.String(),
+			Status:            in.Status,
+			StatusReason:      in.StatusReason,
+			StatusExpiresAt:   formatTime(in.StatusExpiresAt),
+			Email:             in.Email,
+			EmailVerifiedAt:   formatTime(in.EmailVerifiedAt),
+			PreferredLanguage: in.PreferredLanguage,
+			AddressLine:       in.AddressLine,
+			City:              in.City,
+			Country:           in.Country,
+			AvatarKey:         in.AvatarKey,
+		})
+	}
+
+	return &resp, nil
+}
+
+func (u userRPC) ListDuplicateUsers(ctx context.Context, req *pb.ListDuplicateUsersReq) (*pb.ListDuplicateUsersResp, error) {
+
+	candidates, err := u.merge.ListDuplicates(ctx, req.Limit, req.Offset)
+	if err != nil {
+		u.log(ctx).Error("list duplicate users error", zap.Error(err))
+		return nil, err
+	}
+
+	var resp pb.ListDuplicateUsersResp
+	for _, in := range candidates {
+		resp.Duplicates = append(resp.Duplicates, &pb.DuplicateUser{
+			User:      duplicateUser(in.User),
+			Duplicate: duplicateUser(in.Duplicate),
+			Score:     in.Score,
+			FoundAt:   formatTime(in.FoundAt),
+		})
+	}
+
+	return &resp, nil
+}
+
+// duplicateUser carries what a reviewer compares to tell whether two users are the same person
+func duplicateUser(in *entity.User) *pb.User {
+	return &pb.User{
+		Id:          in.Id,
+		FirstName:   in.FirstName,
+		LastName:    in.LastName,
+		BirthDate:   in.BirthDate,
+		PhoneNumber: in.PhoneNumber,
+		Email:       in.Email,
+		CreatedAt:   in.CreatedAt.String(),
+	}
+}
+
+func (u userRPC) MergeUsers(ctx context.Context, req *pb.MergeUsersReq) (*pb.MergeUsersResp, error) {
+
+	merged, err := u.merge.Merge(ctx, req.SurvivorId, req.MergedId)
+	if err != nil {
+		u.log(ctx).Error("merge users error", zap.Error(err))
+		return nil, err
+	}
+
+	// other services point at the merged id until they get the event, a repeated merge sends it again
+	if err := u.brokerProducer.ProduceUserMerged(ctx, merged); err != nil {
+		u.log(ctx).Error("produce user merged event error",
+			zap.String("survivor_id", merged.SurvivorId), zap.String("merged_id", merged.MergedId), zap.Error(err))
+		return nil, entity.NewErrEventNotPublished("user.merged", err)
+	}
+
+	return &pb.MergeUsersResp{
+		SurvivorId: merged.SurvivorId,
+		MergedId:   merged.MergedId,
+		MergedAt:   merged.MergedAt.Format(time.RFC3339),
+	}, nil
+}
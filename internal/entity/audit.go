@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+// audited entity types
+const (
+	AuditEntityUser  = "user"
+	AuditEntityAdmin = "admin"
+)
+
+// audited actions
+const (
+	AuditActionCreate             = "create"
+	AuditActionUpdate             = "update"
+	AuditActionDelete             = "delete"
+	AuditActionRestore            = "restore"
+	AuditActionErase              = "erase"
+	AuditActionChangePassword     = "change_password"
+	AuditActionUpdateRefreshToken = "update_refresh_token"
+	AuditActionInvite             = "invite"
+	AuditActionAcceptInvite       = "accept_invite"
+	AuditActionRevokeInvite       = "revoke_invite"
+	AuditActionTerminate          = "terminate"
+	AuditActionSuspend            = "suspend"
+	AuditActionActivate           = "activate"
+	AuditActionBlock              = "block"
+	AuditActionVerifyEmail        = "verify_email"
+	AuditActionAddContact         = "add_emergency_contact"
+	AuditActionUpdateContact      = "update_emergency_contact"
+	AuditActionDeleteContact      = "delete_emergency_contact"
+	AuditActionAddDependant       = "add_dependant"
+	AuditActionTransferDependant  = "transfer_dependant"
+	AuditActionUpdateMedical      = "update_medical_profile"
+	AuditActionRecordConsent      = "record_consent"
+	AuditActionWithdrawConsent    = "withdraw_consent"
+	AuditActionImport             = "import"
+	AuditActionMerge              = "merge"
+)
+
+// AuditEntry records who changed what on a user or an admin
+type AuditEntry struct {
+	Id         int64                  `json:"id"`
+	EntityType string                 `json:"entity_type"`
+	EntityId   string                 `json:"entity_id"`
+	Action     string                 `json:"action"`
+	ActorId    string                 `json:"actor_id"`
+	ActorType  string                 `json:"actor_type"`
+	RequestId  string                 `json:"request_id"`
+	IP         string                 `json:"ip"`
+	Changes    map[string]AuditChange `json:"changes,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// AuditChange holds the value of a field before and after a mutation, secrets are masked
+type AuditChange struct {
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
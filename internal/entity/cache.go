@@ -0,0 +1,8 @@
+package entity
+
+// CacheInvalidated tells every replica to drop what it cached about an account, EntityType is
+// AuditEntityUser or AuditEntityAdmin
+type CacheInvalidated struct {
+	EntityType string `json:"entity_type"`
+	EntityId   string `json:"entity_id"`
+}
@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+// documents a user consents to
+const (
+	ConsentTypeTerms       = "terms"
+	ConsentTypePrivacy     = "privacy_policy"
+	ConsentTypeDataSharing = "data_sharing"
+)
+
+var ConsentTypes = []string{
+	ConsentTypeTerms,
+	ConsentTypePrivacy,
+	ConsentTypeDataSharing,
+}
+
+// ConsentDocument is a published version of a document, versions of a type count up from 1
+// and are never changed, the latest one is the current version
+type ConsentDocument struct {
+	Type    string
+	Version uint64
+	Url     string
+	// ContentHash is the sha256 of the published text, so the accepted text can be proven later
+	ContentHash string
+	PublishedBy string
+	PublishedAt time.Time
+}
+
+// Consent records that a user accepted a version of a document, it is kept after withdrawal as proof
+type Consent struct {
+	Id              string
+	UserId          string
+	DocumentType    string
+	DocumentVersion uint64
+	// IP is the address the consent was given from, cleared when the user is erased
+	IP          string
+	AcceptedAt  time.Time
+	WithdrawnAt time.Time
+}
+
+// ConsentWithdrawn is published on the consent withdrawn topic so other services stop the processing
+type ConsentWithdrawn struct {
+	UserId          string    `json:"user_id"`
+	DocumentType    string    `json:"document_type"`
+	DocumentVersion uint64    `json:"document_version"`
+	WithdrawnAt     time.Time `json:"withdrawn_at"`
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// relationships of a guardian to its dependant
+const (
+	DependantRelationshipChild   = "child"
+	DependantRelationshipParent  = "parent"
+	DependantRelationshipSpouse  = "spouse"
+	DependantRelationshipSibling = "sibling"
+	DependantRelationshipOther   = "other"
+)
+
+var DependantRelationships = []string{
+	DependantRelationshipChild,
+	DependantRelationshipParent,
+	DependantRelationshipSpouse,
+	DependantRelationshipSibling,
+	DependantRelationshipOther,
+}
+
+// Dependant is a user without credentials, such as a child or an elderly parent,
+// its guardian books and manages on its behalf
+type Dependant struct {
+	User         *User
+	GuardianId   string
+	Relationship string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
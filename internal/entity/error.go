@@ -8,6 +8,8 @@ import (
 var (
 	ErrorConflict = NewErrConflict("object")
 	ErrorNotFound = NewErrNotFound("object")
+
+	ErrorPreconditionFailed = NewErrPreconditionFailed("object")
 )
 
 // error not found
@@ -36,6 +38,64 @@ func NewErrConflict(text string) *ErrConflict {
 	return &ErrConflict{text}
 }
 
+// error precondition failed, the row was changed since the client read it
+type ErrPreconditionFailed struct {
+	name string
+}
+
+func (e *ErrPreconditionFailed) Error() string {
+	return e.name + " was modified by another request, reload it and retry"
+}
+
+func NewErrPreconditionFailed(text string) *ErrPreconditionFailed {
+	return &ErrPreconditionFailed{text}
+}
+
+// error permission denied, the actor lacks a permission
+type ErrPermissionDenied struct {
+	permission string
+}
+
+func (e *ErrPermissionDenied) Error() string {
+	return "permission " + e.permission + " is required"
+}
+
+func NewErrPermissionDenied(permission string) *ErrPermissionDenied {
+	return &ErrPermissionDenied{permission}
+}
+
+// error account inactive, the account exists but its status does not allow logging in
+type ErrAccountInactive struct {
+	status string
+}
+
+func (e *ErrAccountInactive) Error() string {
+	return "account is " + e.status
+}
+
+func NewErrAccountInactive(status string) *ErrAccountInactive {
+	return &ErrAccountInactive{status}
+}
+
+// error event not published, the change is stored but other services were not told of it,
+// repeating the request publishes the event again
+type ErrEventNotPublished struct {
+	event string
+	err   error
+}
+
+func (e *ErrEventNotPublished) Error() string {
+	return e.event + " event was not published, retry the request: " + e.err.Error()
+}
+
+func (e *ErrEventNotPublished) Unwrap() error {
+	return e.err
+}
+
+func NewErrEventNotPublished(event string, err error) *ErrEventNotPublished {
+	return &ErrEventNotPublished{event, err}
+}
+
 // error validation
 type ErrValidation struct {
 	Err    error
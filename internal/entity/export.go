@@ -0,0 +1,58 @@
+package entity
+
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
+// UserExportColumns are the columns an export of users may select, in their default order,
+// passwords and tokens are never exported
+var UserExportColumns = []string{
+	"id",
+	"user_order",
+	"first_name",
+	"last_name",
+	"birth_date",
+	"phone_number",
+	"gender",
+	"status",
+	"status_reason",
+	"status_expires_at",
+	"email",
+	"email_verified_at",
+	"preferred_language",
+	"address_line",
+	"city",
+	"country",
+	"created_at",
+	"updated_at",
+}
+
+// AdminExportColumns are the columns an export of admins may select, in their default order,
+// passwords and tokens are never exported
+var AdminExportColumns = []string{
+	"id",
+	"admin_order",
+	"role",
+	"first_name",
+	"last_name",
+	"birth_date",
+	"phone_number",
+	"email",
+	"gender",
+	"salary",
+	"biography",
+	"start_work_year",
+	"end_work_year",
+	"work_years",
+	"created_at",
+	"updated_at",
+	"terminated_at",
+}
+
+// Export selects the rows of an export and how they are written
+type Export struct {
+	Format  string
+	Columns []string
+	Filter  map[string]string
+}
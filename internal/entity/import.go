@@ -0,0 +1,29 @@
+package entity
+
+// outcomes of a row of a user import
+const (
+	ImportStatusImported = "imported"
+	// ImportStatusValid is a row a dry run would import
+	ImportStatusValid     = "valid"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusFailed    = "failed"
+)
+
+// UserImportRow is the outcome of a row of the CSV, Line is its line in the file
+type UserImportRow struct {
+	Line        int
+	Status      string
+	UserId      string
+	PhoneNumber string
+	// Errors maps a column to what is wrong with it
+	Errors map[string]string
+}
+
+// UserImportReport is the outcome of a user import, nothing is written on a dry run
+type UserImportReport struct {
+	DryRun     bool
+	Imported   int
+	Duplicates int
+	Failed     int
+	Rows       []*UserImportRow
+}
@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+// invite delivery channels
+const (
+	InviteChannelEmail = "email"
+	InviteChannelSMS   = "sms"
+)
+
+// invite statuses
+const (
+	InviteStatusPending  = "pending"
+	InviteStatusAccepted = "accepted"
+	InviteStatusRevoked  = "revoked"
+	InviteStatusExpired  = "expired"
+)
+
+// AdminInvite lets an invited admin choose a password once before ExpiresAt,
+// only the hash of the token is stored
+type AdminInvite struct {
+	Id         string
+	AdminId    string
+	TokenHash  string
+	Channel    string
+	Recipient  string
+	InvitedBy  string
+	ExpiresAt  time.Time
+	AcceptedAt time.Time
+	RevokedAt  time.Time
+	CreatedAt  time.Time
+	// Notified reports whether the notification service delivered the invite, it is not stored
+	Notified bool
+}
+
+func (i *AdminInvite) Status(now time.Time) string {
+	switch {
+	case !i.AcceptedAt.IsZero():
+		return InviteStatusAccepted
+	case !i.RevokedAt.IsZero():
+		return InviteStatusRevoked
+	case !now.Before(i.ExpiresAt):
+		return InviteStatusExpired
+	}
+	return InviteStatusPending
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// BloodTypes are the ABO and Rh blood types a medical profile accepts, empty when unknown
+var BloodTypes = []string{"A+", "A-", "B+", "B-", "AB+", "AB-", "O+", "O-"}
+
+// MedicalProfile is the baseline medical information of a patient, stored encrypted
+type MedicalProfile struct {
+	UserId            string
+	BloodType         string
+	Allergies         []string
+	ChronicConditions []string
+	Medications       []string
+	Version           uint64
+	UpdatedBy         string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
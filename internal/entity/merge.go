@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// DuplicateCandidate is a pair of live users that are likely the same person, User sorts before
+// Duplicate by id and Score is the similarity of their names between 0 and 1
+type DuplicateCandidate struct {
+	User      *User
+	Duplicate *User
+	Score     float64
+	FoundAt   time.Time
+}
+
+// UserMerged tells other services to move what they hold about MergedId to SurvivorId
+type UserMerged struct {
+	SurvivorId string    `json:"survivor_id"`
+	MergedId   string    `json:"merged_id"`
+	MergedAt   time.Time `json:"merged_at"`
+}
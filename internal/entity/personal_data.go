@@ -0,0 +1,62 @@
+package entity
+
+import "time"
+
+// UserDataExport is everything the service holds about a user, returned on a data subject request
+type UserDataExport struct {
+	GeneratedAt       time.Time                `json:"generated_at"`
+	Profile           UserProfileExport        `json:"profile"`
+	Sessions          []SessionExport          `json:"sessions"`
+	EmergencyContacts []EmergencyContactExport `json:"emergency_contacts"`
+	Consents          []ConsentExport          `json:"consents"`
+	AuditLog          []AuditEntry             `json:"audit_log"`
+}
+
+type UserProfileExport struct {
+	Id                string     `json:"id"`
+	UserOrder         uint64     `json:"user_order"`
+	FirstName         string     `json:"first_name"`
+	LastName          string     `json:"last_name"`
+	BirthDate         string     `json:"birth_date"`
+	PhoneNumber       string     `json:"phone_number"`
+	Gender            string     `json:"gender"`
+	Status            string     `json:"status"`
+	StatusReason      string     `json:"status_reason,omitempty"`
+	Email             string     `json:"email,omitempty"`
+	EmailVerifiedAt   *time.Time `json:"email_verified_at,omitempty"`
+	PreferredLanguage string     `json:"preferred_language,omitempty"`
+	AddressLine       string     `json:"address_line,omitempty"`
+	City              string     `json:"city,omitempty"`
+	Country           string     `json:"country,omitempty"`
+	AvatarKey         string     `json:"avatar_key,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+}
+
+type EmergencyContactExport struct {
+	FullName     string    `json:"full_name"`
+	Relationship string    `json:"relationship"`
+	PhoneNumber  string    `json:"phone_number"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type ConsentExport struct {
+	DocumentType    string     `json:"document_type"`
+	DocumentVersion uint64     `json:"document_version"`
+	IP              string     `json:"ip,omitempty"`
+	AcceptedAt      time.Time  `json:"accepted_at"`
+	WithdrawnAt     *time.Time `json:"withdrawn_at,omitempty"`
+}
+
+// SessionExport describes a login session without its credentials
+type SessionExport struct {
+	Kind   string `json:"kind"`
+	Active bool   `json:"active"`
+}
+
+// UserErased is published on the user.erased topic after a user's personal data was anonymised
+type UserErased struct {
+	UserId   string    `json:"user_id"`
+	ErasedAt time.Time `json:"erased_at"`
+}
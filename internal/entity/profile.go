@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// MaxEmergencyContacts is the number of emergency contacts a user may keep
+const MaxEmergencyContacts = 5
+
+// EmergencyContact is a person to call when a user can not be reached
+type EmergencyContact struct {
+	Id           string
+	UserId       string
+	FullName     string
+	Relationship string
+	PhoneNumber  string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// EmailVerification lets a user prove it owns Email once before ExpiresAt,
+// only the hash of the token is stored
+type EmailVerification struct {
+	Id         string
+	UserId     string
+	Email      string
+	TokenHash  string
+	ExpiresAt  time.Time
+	VerifiedAt time.Time
+	CreatedAt  time.Time
+}
@@ -0,0 +1,80 @@
+package entity
+
+import "time"
+
+// permissions checked by Authorize, PermissionAll grants every permission
+const (
+	PermissionAll = "*"
+
+	PermissionUsersRead   = "users.read"
+	PermissionUsersWrite  = "users.write"
+	PermissionUsersDelete = "users.delete"
+	PermissionUsersMerge  = "users.merge"
+
+	PermissionAdminsRead   = "admins.read"
+	PermissionAdminsWrite  = "admins.write"
+	PermissionAdminsDelete = "admins.delete"
+
+	PermissionRolesRead  = "roles.read"
+	PermissionRolesWrite = "roles.write"
+
+	PermissionAuditRead = "audit.read"
+
+	// medical profiles are not part of users.read, only staff treating patients should hold them
+	PermissionMedicalRead  = "medical.read"
+	PermissionMedicalWrite = "medical.write"
+
+	// publishes new versions of the terms, the privacy policy and the data sharing agreement
+	PermissionConsentsWrite = "consents.write"
+)
+
+// Permissions lists every permission a role may grant
+var Permissions = []string{
+	PermissionAll,
+	PermissionUsersRead,
+	PermissionUsersWrite,
+	PermissionUsersDelete,
+	PermissionUsersMerge,
+	PermissionAdminsRead,
+	PermissionAdminsWrite,
+	PermissionAdminsDelete,
+	PermissionRolesRead,
+	PermissionRolesWrite,
+	PermissionAuditRead,
+	PermissionMedicalRead,
+	PermissionMedicalWrite,
+	PermissionConsentsWrite,
+}
+
+// actor types sent by the api gateway in the x-actor-type metadata
+const (
+	ActorTypeAdmin = "admin"
+	ActorTypeUser  = "user"
+)
+
+// role assignment actions
+const (
+	RoleAssign = "assign"
+	RoleRevoke = "revoke"
+)
+
+type Role struct {
+	Id          string
+	Name        string
+	Description string
+	Permissions []string
+	BuiltIn     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RoleAssignment is an entry of the role assignment history of an admin
+type RoleAssignment struct {
+	Id        int64
+	AdminId   string
+	RoleId    string
+	RoleName  string
+	Action    string
+	ActorId   string
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package entity
+
+// user statuses, only active users may log in
+const (
+	UserStatusPending   = "pending"
+	UserStatusActive    = "active"
+	UserStatusSuspended = "suspended"
+	UserStatusBlocked   = "blocked"
+)
+
+// UserStatusTransitions lists the statuses a user may move to from each status,
+// suspended and blocked users with an expiry become active again once it passes
+var UserStatusTransitions = map[string][]string{
+	UserStatusPending:   {UserStatusActive, UserStatusBlocked},
+	UserStatusActive:    {UserStatusSuspended, UserStatusBlocked},
+	UserStatusSuspended: {UserStatusActive, UserStatusSuspended, UserStatusBlocked},
+	UserStatusBlocked:   {UserStatusActive},
+}
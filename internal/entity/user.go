@@ -12,8 +12,30 @@ type User struct {
 	Password     string
 	Gender       string
 	RefreshToken string
+	Version      uint64
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	DeletedAt    time.Time
+	// Status is one of the UserStatus constants, a lapsed suspension or block reads as active
+	Status          string
+	StatusReason    string
+	StatusExpiresAt time.Time
+	// Email is optional, EmailVerifiedAt is zero until the user proves it owns the address
+	Email             string
+	EmailVerifiedAt   time.Time
+	PreferredLanguage string
+	AddressLine       string
+	City              string
+	// Country is an ISO 3166-1 alpha-2 code
+	Country string
+	// AvatarKey is the object storage key of the avatar image
+	AvatarKey string
+	// EmergencyContacts are only read by Get
+	EmergencyContacts []*EmergencyContact
+	// AcceptedTermsVersion is the version of the terms accepted at signup
+	AcceptedTermsVersion uint64
+	// Consents are only written by Create, in the same transaction as the user
+	Consents []*Consent
 }
 
 type Admin struct {
@@ -33,8 +55,20 @@ type Admin struct {
 	EndWorkYear   string
 	WorkYears     uint64
 	RefreshToken  string
+	Version       uint64
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	DeletedAt     time.Time
+	// TerminatedAt is set when the employment ended, the admin can no longer log in
+	TerminatedAt time.Time
+}
+
+// SalaryChange is an entry of the salary history of an admin
+type SalaryChange struct {
+	Id        int64
+	AdminId   string
+	Salary    float32
+	CreatedAt time.Time
 }
 
 type CheckFieldReq struct {
@@ -70,10 +104,12 @@ type ChangeAdminPasswordReq struct {
 }
 
 type ChangePasswordResp struct {
+	Id     string
 	Status bool
 }
 
 type ChangeAdminPasswordResp struct {
+	Id     string
 	Status bool
 }
 
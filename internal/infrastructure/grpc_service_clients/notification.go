@@ -0,0 +1,53 @@
+package grpc_service_clients
+
+import (
+	"context"
+	notificationpb "dennic_user_service/genproto/notification_service"
+	"dennic_user_service/internal/entity"
+	"time"
+)
+
+const (
+	// inviteTemplate is the notification service template of admin invites
+	inviteTemplate = "admin_invite"
+	// emailVerificationTemplate is the notification service template of user email verifications
+	emailVerificationTemplate = "email_verification"
+)
+
+// Notifier delivers messages to admins and users through the notification service
+type Notifier interface {
+	SendInvite(ctx context.Context, invite *entity.AdminInvite, token string) error
+	SendEmailVerification(ctx context.Context, verification *entity.EmailVerification, token string) error
+}
+
+type notifier struct {
+	client notificationpb.NotificationServiceClient
+}
+
+func (n notifier) SendInvite(ctx context.Context, invite *entity.AdminInvite, token string) error {
+	_, err := n.client.Send(ctx, &notificationpb.SendReq{
+		Channel:   invite.Channel,
+		Recipient: invite.Recipient,
+		Template:  inviteTemplate,
+		Params: map[string]string{
+			"invite_id":  invite.Id,
+			"token":      token,
+			"expires_at": invite.ExpiresAt.Format(time.RFC3339),
+		},
+	})
+	return err
+}
+
+func (n notifier) SendEmailVerification(ctx context.Context, verification *entity.EmailVerification, token string) error {
+	_, err := n.client.Send(ctx, &notificationpb.SendReq{
+		Channel:   entity.InviteChannelEmail,
+		Recipient: verification.Email,
+		Template:  emailVerificationTemplate,
+		Params: map[string]string{
+			"verification_id": verification.Id,
+			"token":           token,
+			"expires_at":      verification.ExpiresAt.Format(time.RFC3339),
+		},
+	})
+	return err
+}
@@ -1,24 +1,55 @@
 package grpc_service_clients
 
 import (
+	notificationpb "dennic_user_service/genproto/notification_service"
 	"dennic_user_service/internal/pkg/config"
+	"fmt"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ServiceClients interface {
 	// SmsService()
+	// Notifier returns nil when no notification service is configured
+	Notifier() Notifier
 	Close()
 }
 
 type serviceClients struct {
+	notifier Notifier
 	services []*grpc.ClientConn
 }
 
 func New(config *config.Config) (ServiceClients, error) {
-	return &serviceClients{
+	clients := &serviceClients{
 		services: []*grpc.ClientConn{},
-	}, nil
+	}
+
+	// notification service
+	if config.NotificationService.Host != "" {
+		conn, err := dial(config.NotificationService.Host + config.NotificationService.Port)
+		if err != nil {
+			return nil, fmt.Errorf("notification service dial host: %s port: %s: %w",
+				config.NotificationService.Host, config.NotificationService.Port, err)
+		}
+		clients.services = append(clients.services, conn)
+		clients.notifier = notifier{client: notificationpb.NewNotificationServiceClient(conn)}
+	}
+
+	return clients, nil
+}
+
+func dial(target string) (*grpc.ClientConn, error) {
+	return grpc.Dial(target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+	)
+}
+
+func (s *serviceClients) Notifier() Notifier {
+	return s.notifier
 }
 
 func (s *serviceClients) Close() {
@@ -2,6 +2,8 @@ package kafka
 
 import (
 	"context"
+	"dennic_user_service/internal/pkg/metrics"
+	"dennic_user_service/internal/pkg/reqinfo"
 	"dennic_user_service/internal/usecase/event"
 
 	"github.com/segmentio/kafka-go"
@@ -33,13 +35,18 @@ func (c *consumer) RegisterConsumer(consumerConfig event.ConsumerConfig) {
 
 func (c *consumer) Run() {
 	for _, consumerConfig := range c.consumerConfigs {
-		r := kafka.NewReader(kafka.ReaderConfig{
+		readerConfig := kafka.ReaderConfig{
 			Brokers:  consumerConfig.GetBrokers(),
 			Topic:    consumerConfig.GetTopic(),
 			GroupID:  consumerConfig.GetGroupID(),
 			MinBytes: MinBytes,
 			MaxBytes: MaxBytes,
-		})
+		}
+		// a replica that starts has nothing older to catch up on
+		if consumerConfig.GetBroadcast() {
+			readerConfig.StartOffset = kafka.LastOffset
+		}
+		r := kafka.NewReader(readerConfig)
 		c.readers = append(c.readers, r)
 		go runReader(r, consumerConfig, c.logger)
 	}
@@ -59,18 +66,31 @@ func runReader(r *kafka.Reader, consumerConfig event.ConsumerConfig, logger *zap
 		handler = consumerConfig.GetHandler()
 	)
 	for {
-		ctx := context.Background()
+		// consumers are internal callers, the producer of a message is not the actor
+		ctx := reqinfo.Internal(context.Background())
 		m, err := r.FetchMessage(ctx)
 		if err != nil {
 			logger.Error("consumer failed to fetch message:", zap.String("topic", topic), zap.Error(err))
 			break
 		}
+		metrics.KafkaReaderLag.WithLabelValues(topic).Set(float64(r.Stats().Lag))
 
 		if err := handler(ctx, m.Key, m.Value); err != nil {
-			logger.Error("consumer failed to handler message:", zap.ByteString("value", m.Value), zap.String("topic", topic), zap.Error(err))
+			// message values carry personal data, only their position is logged
+			logger.Error("consumer failed to handler message:",
+				zap.String("topic", topic),
+				zap.Int("partition", m.Partition),
+				zap.Int64("offset", m.Offset),
+				zap.ByteString("key", m.Key),
+				zap.Error(err),
+			)
 			continue
 		}
 
+		// broadcast groups commit nothing, so the group of a replica that is gone leaves no offsets behind
+		if consumerConfig.GetBroadcast() {
+			continue
+		}
 		if err := r.CommitMessages(ctx, m); err != nil {
 			logger.Error("consumer failed to commit messages:", zap.String("topic", topic), zap.Error(err))
 		}
@@ -78,10 +98,11 @@ func runReader(r *kafka.Reader, consumerConfig event.ConsumerConfig, logger *zap
 }
 
 type ConsumerConfig struct {
-	brokers []string
-	topic   string
-	groupID string
-	handler HandlerFunc
+	brokers   []string
+	topic     string
+	groupID   string
+	handler   HandlerFunc
+	broadcast bool
 }
 
 func NewConsumerConfig(
@@ -98,6 +119,20 @@ func NewConsumerConfig(
 	}
 }
 
+// NewBroadcastConsumerConfig reads every message in each replica, groupID has to be unique to the
+// replica. It starts at the newest message and commits none, for events that only matter to a
+// running replica such as cache invalidations
+func NewBroadcastConsumerConfig(
+	brokers []string,
+	topic string,
+	groupID string,
+	handler HandlerFunc,
+) *ConsumerConfig {
+	consumerConfig := NewConsumerConfig(brokers, topic, groupID, handler)
+	consumerConfig.broadcast = true
+	return consumerConfig
+}
+
 func (c *ConsumerConfig) GetBrokers() []string {
 	return c.brokers
 }
@@ -110,6 +145,10 @@ func (c *ConsumerConfig) GetGroupID() string {
 	return c.groupID
 }
 
+func (c *ConsumerConfig) GetBroadcast() bool {
+	return c.broadcast
+}
+
 func (c *ConsumerConfig) GetHandler() func(ctx context.Context, key, value []byte) error {
 	return c.handler
 }
\ No newline at end of file
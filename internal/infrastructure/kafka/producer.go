@@ -4,6 +4,9 @@ import (
 	"context"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/metrics"
+	"encoding/json"
+	"fmt"
 
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
@@ -12,32 +15,53 @@ import (
 type producer struct {
 	logger            *zap.Logger
 	investmentCreated *kafka.Writer
+	userErased        *kafka.Writer
+	consentWithdrawn  *kafka.Writer
+	cacheInvalidated  *kafka.Writer
+	userMerged        *kafka.Writer
 }
 
 func NewProducer(config *config.Config, logger *zap.Logger) *producer {
 	return &producer{
-		logger: logger,
-		investmentCreated: &kafka.Writer{
-			Addr:                   kafka.TCP(config.Kafka.Address...),
-			Topic:                  config.Kafka.Topic.InvestorCreate,
-			Balancer:               &kafka.Hash{},
-			RequiredAcks:           kafka.RequireAll,
-			AllowAutoTopicCreation: true,
-			Async:                  true, // make the writer asynchronous
-			Completion: func(messages []kafka.Message, err error) {
-				if err != nil {
-					logger.Error("kafka investmentCreated", zap.Error(err))
-				}
-				for _, message := range messages {
-					logger.Sugar().Info(
-						"kafka investmentCreated message",
-						zap.Int("partition", message.Partition),
-						zap.Int64("offset", message.Offset),
-						zap.String("key", string(message.Key)),
-						zap.String("value", string(message.Value)),
-					)
-				}
-			},
+		logger:            logger,
+		investmentCreated: newWriter(config, logger, config.Kafka.Topic.InvestorCreate, true),
+		userErased:        newWriter(config, logger, config.Kafka.Topic.UserErased, false),
+		consentWithdrawn:  newWriter(config, logger, config.Kafka.Topic.ConsentWithdrawn, false),
+		cacheInvalidated:  newWriter(config, logger, config.Kafka.Topic.CacheInvalidated, true),
+		userMerged:        newWriter(config, logger, config.Kafka.Topic.UserMerged, false),
+	}
+}
+
+// newWriter writes to topic. Events other services have to act on are written synchronously, so
+// WriteMessages returns once the brokers stored them or with the error they failed with, and each
+// is sent on its own rather than waiting for a batch to fill
+func newWriter(config *config.Config, logger *zap.Logger, topic string, async bool) *kafka.Writer {
+	batchSize := 0
+	if !async {
+		batchSize = 1
+	}
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(config.Kafka.Address...),
+		Topic:                  topic,
+		Balancer:               &kafka.Hash{},
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+		Async:                  async,
+		BatchSize:              batchSize,
+		Completion: func(messages []kafka.Message, err error) {
+			if err != nil {
+				logger.Error("kafka "+topic, zap.Error(err))
+				metrics.KafkaWriterErrors.WithLabelValues(topic).Add(float64(len(messages)))
+			}
+			// message values carry personal data, only their position is logged
+			for _, message := range messages {
+				logger.Debug(
+					"kafka "+topic+" message",
+					zap.Int("partition", message.Partition),
+					zap.Int64("offset", message.Offset),
+					zap.String("key", string(message.Key)),
+				)
+			}
 		},
 	}
 }
@@ -68,8 +92,61 @@ func (p *producer) ProduceContent(ctx context.Context, key string, value *entity
 	return nil
 }
 
+// ProduceUserErased tells other services to drop or anonymise what they hold about the user
+func (p *producer) ProduceUserErased(ctx context.Context, value *entity.UserErased) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error during marshal user erased event: %w", err)
+	}
+
+	return p.userErased.WriteMessages(ctx, p.BuildMessageWithTracing(value.UserId, body))
+}
+
+// ProduceConsentWithdrawn tells other services to stop the processing the user no longer consents to
+func (p *producer) ProduceConsentWithdrawn(ctx context.Context, value *entity.ConsentWithdrawn) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error during marshal consent withdrawn event: %w", err)
+	}
+
+	return p.consentWithdrawn.WriteMessages(ctx, p.BuildMessageWithTracing(value.UserId, body))
+}
+
+// ProduceCacheInvalidated tells the other replicas to drop their cached copy of an account
+func (p *producer) ProduceCacheInvalidated(ctx context.Context, value *entity.CacheInvalidated) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error during marshal cache invalidated event: %w", err)
+	}
+
+	return p.cacheInvalidated.WriteMessages(ctx, p.BuildMessageWithTracing(value.EntityId, body))
+}
+
+// ProduceUserMerged tells other services to move what they hold about the merged user to the survivor,
+// it is keyed by the merged user like the other events about that account
+func (p *producer) ProduceUserMerged(ctx context.Context, value *entity.UserMerged) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("error during marshal user merged event: %w", err)
+	}
+
+	return p.userMerged.WriteMessages(ctx, p.BuildMessageWithTracing(value.MergedId, body))
+}
+
 func (p *producer) Close() {
 	if err := p.investmentCreated.Close(); err != nil {
 		p.logger.Error("error during close writer articleCategoryCreated", zap.Error(err))
 	}
+	if err := p.userErased.Close(); err != nil {
+		p.logger.Error("error during close writer userErased", zap.Error(err))
+	}
+	if err := p.consentWithdrawn.Close(); err != nil {
+		p.logger.Error("error during close writer consentWithdrawn", zap.Error(err))
+	}
+	if err := p.cacheInvalidated.Close(); err != nil {
+		p.logger.Error("error during close writer cacheInvalidated", zap.Error(err))
+	}
+	if err := p.userMerged.Close(); err != nil {
+		p.logger.Error("error during close writer userMerged", zap.Error(err))
+	}
 }
@@ -3,16 +3,30 @@ package repository
 import (
 	"context"
 	"dennic_user_service/internal/entity"
+	"time"
 )
 
 type AdminStorageI interface {
 	Create(ctx context.Context, admin *entity.Admin) error
 	Get(ctx context.Context, params map[string]string) (*entity.Admin, error)
+	GetPassword(ctx context.Context, id string) (string, error)
 	List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Admin, error)
-	Update(ctx context.Context, kyc *entity.Admin) error
+	Update(ctx context.Context, kyc *entity.Admin, fields []string) error
 	Delete(ctx context.Context, id string) error
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	IfExists(ctx context.Context, req *entity.IfAdminExistsReq) (*entity.IfExistsResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error)
 	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Admin, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	CreateInvite(ctx context.Context, admin *entity.Admin, invite *entity.AdminInvite) error
+	AcceptInvite(ctx context.Context, tokenHash, password string) (string, error)
+	ListInvites(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AdminInvite, error)
+	RevokeInvite(ctx context.Context, id string) (string, error)
+	Terminate(ctx context.Context, id, endDate string, workYears uint64) (time.Time, error)
+	ListSalaryHistory(ctx context.Context, adminId string) ([]*entity.SalaryChange, error)
+	RecomputeWorkYears(ctx context.Context) ([]string, error)
+	Export(ctx context.Context, columns []string, filter map[string]string, fn func([]any) error) error
+	GetMany(ctx context.Context, ids []string) ([]*entity.Admin, error)
 }
@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type AuditStorageI interface {
+	Create(ctx context.Context, entry *entity.AuditEntry) error
+	List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditEntry, error)
+}
@@ -0,0 +1,153 @@
+package cached
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/cache"
+	"time"
+)
+
+type adminRepo struct {
+	repository.AdminStorageI
+	lookup lookup
+}
+
+// NewAdminRepo caches Get of admins by id for ttl and missing admins for notFoundTTL, publisher may
+// be nil when a single replica runs
+func NewAdminRepo(repo repository.AdminStorageI, cache cache.Cache, publisher Publisher, ttl, notFoundTTL time.Duration) *adminRepo {
+	return &adminRepo{
+		AdminStorageI: repo,
+		lookup: lookup{
+			cache:       cache,
+			publisher:   publisher,
+			entityType:  entity.AuditEntityAdmin,
+			ttl:         ttl,
+			notFoundTTL: notFoundTTL,
+		},
+	}
+}
+
+// Get caches admins without their password hash and refresh token, a cached admin is served with
+// the password read from the database
+func (r *adminRepo) Get(ctx context.Context, params map[string]string) (*entity.Admin, error) {
+	id, ok := cachedGet(params)
+	if !ok {
+		return r.AdminStorageI.Get(ctx, params)
+	}
+
+	var admin entity.Admin
+	if found, err := r.lookup.read(ctx, id, &admin); found {
+		if err != nil {
+			return nil, err
+		}
+		if admin.Password, err = r.AdminStorageI.GetPassword(ctx, id); err != nil {
+			return nil, err
+		}
+		return &admin, nil
+	}
+
+	loaded, err := r.AdminStorageI.Get(ctx, params)
+	if err != nil {
+		r.lookup.write(ctx, id, nil, err)
+		return nil, err
+	}
+	cached := *loaded
+	cached.Password, cached.RefreshToken = "", ""
+	r.lookup.write(ctx, id, &cached, nil)
+
+	return loaded, nil
+}
+
+// Create drops a cached miss of the new admin
+func (r *adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
+	if err := r.AdminStorageI.Create(ctx, admin); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, admin.Id)
+
+	return nil
+}
+
+func (r *adminRepo) Update(ctx context.Context, admin *entity.Admin, fields []string) error {
+	if err := r.AdminStorageI.Update(ctx, admin, fields); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, admin.Id)
+
+	return nil
+}
+
+func (r *adminRepo) Delete(ctx context.Context, id string) error {
+	if err := r.AdminStorageI.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return nil
+}
+
+func (r *adminRepo) ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error) {
+	resp, err := r.AdminStorageI.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status {
+		r.lookup.invalidate(ctx, resp.Id)
+	}
+
+	return resp, nil
+}
+
+func (r *adminRepo) Restore(ctx context.Context, id string) error {
+	if err := r.AdminStorageI.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return nil
+}
+
+// AcceptInvite drops the cached miss of the admin that became active
+func (r *adminRepo) AcceptInvite(ctx context.Context, tokenHash, password string) (string, error) {
+	id, err := r.AdminStorageI.AcceptInvite(ctx, tokenHash, password)
+	if err != nil {
+		return id, err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return id, nil
+}
+
+func (r *adminRepo) RevokeInvite(ctx context.Context, id string) (string, error) {
+	adminId, err := r.AdminStorageI.RevokeInvite(ctx, id)
+	if err != nil {
+		return adminId, err
+	}
+	r.lookup.invalidate(ctx, adminId)
+
+	return adminId, nil
+}
+
+func (r *adminRepo) Terminate(ctx context.Context, id, endDate string, workYears uint64) (time.Time, error) {
+	terminatedAt, err := r.AdminStorageI.Terminate(ctx, id, endDate, workYears)
+	if err != nil {
+		return terminatedAt, err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return terminatedAt, nil
+}
+
+// RecomputeWorkYears drops every admin whose work years changed
+func (r *adminRepo) RecomputeWorkYears(ctx context.Context) ([]string, error) {
+	ids, err := r.AdminStorageI.RecomputeWorkYears(ctx)
+	if err != nil {
+		return ids, err
+	}
+	for _, id := range ids {
+		r.lookup.invalidate(ctx, id)
+	}
+
+	return ids, nil
+}
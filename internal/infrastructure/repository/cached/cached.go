@@ -0,0 +1,102 @@
+// Package cached decorates the user and admin repositories with a read-through cache of Get by id,
+// accounts that do not exist are cached for a shorter time, every write through the decorator drops
+// the cached account and announces it so the other replicas drop theirs
+package cached
+
+import (
+	"bytes"
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/cache"
+	"dennic_user_service/internal/pkg/metrics"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Publisher announces a dropped account to the other replicas
+type Publisher interface {
+	ProduceCacheInvalidated(ctx context.Context, value *entity.CacheInvalidated) error
+}
+
+// Key is the cache key of an account of entityType
+func Key(entityType, id string) string {
+	return entityType + ":" + id
+}
+
+// notFound is cached for accounts that do not exist
+var notFound = []byte("null")
+
+type lookup struct {
+	cache       cache.Cache
+	publisher   Publisher
+	entityType  string
+	ttl         time.Duration
+	notFoundTTL time.Duration
+}
+
+// read decodes the cached account into value, found is false on a miss and err is
+// entity.ErrorNotFound for an account cached as missing, a failing cache is a miss
+func (l lookup) read(ctx context.Context, id string, value any) (found bool, err error) {
+	body, ok, err := l.cache.Get(ctx, Key(l.entityType, id))
+	switch {
+	case err != nil:
+		metrics.CacheLookups.WithLabelValues(l.entityType, "error").Inc()
+		return false, nil
+	case !ok:
+		metrics.CacheLookups.WithLabelValues(l.entityType, "miss").Inc()
+		return false, nil
+	case bytes.Equal(body, notFound):
+		metrics.CacheLookups.WithLabelValues(l.entityType, "negative_hit").Inc()
+		return true, entity.ErrorNotFound
+	}
+	if err := json.Unmarshal(body, value); err != nil {
+		metrics.CacheLookups.WithLabelValues(l.entityType, "error").Inc()
+		return false, nil
+	}
+	metrics.CacheLookups.WithLabelValues(l.entityType, "hit").Inc()
+
+	return true, nil
+}
+
+// write caches what the repository returned for id, a missing account or the account, other
+// errors are not cached
+func (l lookup) write(ctx context.Context, id string, value any, err error) {
+	key := Key(l.entityType, id)
+	if errors.Is(err, entity.ErrorNotFound) {
+		_ = l.cache.Set(ctx, key, notFound, l.notFoundTTL)
+		return
+	}
+	if err != nil {
+		return
+	}
+	if body, err := json.Marshal(value); err == nil {
+		_ = l.cache.Set(ctx, key, body, l.ttl)
+	}
+}
+
+// invalidate drops the cached accounts and announces them, a failure leaves the account cached
+// until its ttl passes
+func (l lookup) invalidate(ctx context.Context, ids ...string) {
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = Key(l.entityType, id)
+	}
+	if err := l.cache.Delete(ctx, keys...); err != nil {
+		metrics.CacheInvalidationErrors.WithLabelValues(l.entityType).Add(float64(len(ids)))
+	}
+	if l.publisher == nil {
+		return
+	}
+	for _, id := range ids {
+		if err := l.publisher.ProduceCacheInvalidated(ctx, &entity.CacheInvalidated{EntityType: l.entityType, EntityId: id}); err != nil {
+			metrics.CacheInvalidationErrors.WithLabelValues(l.entityType).Inc()
+		}
+	}
+}
+
+// cachedGet reports whether params only select by id, the lookups the cache serves
+func cachedGet(params map[string]string) (string, bool) {
+	id, ok := params["id"]
+	return id, ok && len(params) == 1 && id != ""
+}
@@ -0,0 +1,220 @@
+package cached
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/cache"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CachedTestSuite struct {
+	suite.Suite
+	users     *userStorageStub
+	cache     *cache.LRU
+	publisher *publisherStub
+	repo      *userRepo
+}
+
+// userStorageStub serves the users it holds and counts the reads that reach it
+type userStorageStub struct {
+	repository.UserStorageI
+	users map[string]*entity.User
+	reads int
+}
+
+func (u *userStorageStub) Get(_ context.Context, params map[string]string) (*entity.User, error) {
+	u.reads++
+	user, ok := u.users[params["id"]]
+	if !ok {
+		return nil, entity.ErrorNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (u *userStorageStub) GetPassword(_ context.Context, id string) (string, error) {
+	user, ok := u.users[id]
+	if !ok {
+		return "", entity.ErrorNotFound
+	}
+	return user.Password, nil
+}
+
+func (u *userStorageStub) Update(_ context.Context, user *entity.User, _ []string) error {
+	u.users[user.Id] = user
+	return nil
+}
+
+func (u *userStorageStub) Create(_ context.Context, user *entity.User) error {
+	u.users[user.Id] = user
+	return nil
+}
+
+func (u *userStorageStub) ChangePassword(_ context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error) {
+	for _, user := range u.users {
+		if user.PhoneNumber == req.PhoneNumber {
+			user.Password = req.Password
+			return &entity.ChangePasswordResp{Id: user.Id, Status: true}, nil
+		}
+	}
+	return &entity.ChangePasswordResp{}, nil
+}
+
+// adminStorageStub serves a single admin whose work years a recompute increments
+type adminStorageStub struct {
+	repository.AdminStorageI
+	admin entity.Admin
+}
+
+func (a *adminStorageStub) Get(context.Context, map[string]string) (*entity.Admin, error) {
+	copied := a.admin
+	return &copied, nil
+}
+
+func (a *adminStorageStub) RecomputeWorkYears(context.Context) ([]string, error) {
+	a.admin.WorkYears++
+	return []string{a.admin.Id}, nil
+}
+
+type publisherStub struct {
+	events []*entity.CacheInvalidated
+}
+
+func (p *publisherStub) ProduceCacheInvalidated(_ context.Context, value *entity.CacheInvalidated) error {
+	p.events = append(p.events, value)
+	return nil
+}
+
+// failingCache fails every call, as redis does while it is down
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string) ([]byte, bool, error) {
+	return nil, false, errors.New("connection refused")
+}
+
+func (failingCache) Set(context.Context, string, []byte, time.Duration) error {
+	return errors.New("connection refused")
+}
+
+func (failingCache) Delete(context.Context, ...string) error {
+	return errors.New("connection refused")
+}
+
+func (s *CachedTestSuite) SetupTest() {
+	s.users = &userStorageStub{users: map[string]*entity.User{
+		"first": {Id: "first", FirstName: "Ali", PhoneNumber: "+998901111111", Password: "old"},
+	}}
+	s.cache = cache.NewLRU(10)
+	s.publisher = &publisherStub{}
+	s.repo = NewUserRepo(s.users, s.cache, s.publisher, time.Minute, time.Minute)
+}
+
+func (s *CachedTestSuite) TestReadThrough() {
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		user, err := s.repo.Get(ctx, map[string]string{"id": "first"})
+		s.Require().NoError(err)
+		s.Equal("Ali", user.FirstName)
+	}
+	s.Equal(1, s.users.reads)
+
+	// lookups by anything but the id alone are not cached
+	_, err := s.repo.Get(ctx, map[string]string{"id": "first", "phone_number": "+998901111111"})
+	s.NoError(err)
+	s.Equal(2, s.users.reads)
+}
+
+func (s *CachedTestSuite) TestSecretsNotCached() {
+	ctx := context.Background()
+	s.users.users["first"].RefreshToken = "token"
+	for i := 0; i < 2; i++ {
+		user, err := s.repo.Get(ctx, map[string]string{"id": "first"})
+		s.Require().NoError(err)
+		s.Equal("old", user.Password)
+	}
+	s.Equal(1, s.users.reads)
+
+	body, ok, err := s.cache.Get(ctx, Key(entity.AuditEntityUser, "first"))
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	var cached entity.User
+	s.Require().NoError(json.Unmarshal(body, &cached))
+	s.Equal("Ali", cached.FirstName)
+	s.Empty(cached.Password)
+	s.Empty(cached.RefreshToken)
+}
+
+func (s *CachedTestSuite) TestNotFound() {
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err := s.repo.Get(ctx, map[string]string{"id": "second"})
+		s.ErrorIs(err, entity.ErrorNotFound)
+	}
+	s.Equal(1, s.users.reads)
+
+	// creating the user drops the cached miss
+	s.NoError(s.repo.Create(ctx, &entity.User{Id: "second", FirstName: "Vali"}))
+	user, err := s.repo.Get(ctx, map[string]string{"id": "second"})
+	s.Require().NoError(err)
+	s.Equal("Vali", user.FirstName)
+}
+
+func (s *CachedTestSuite) TestInvalidation() {
+	ctx := context.Background()
+	_, err := s.repo.Get(ctx, map[string]string{"id": "first"})
+	s.Require().NoError(err)
+
+	s.NoError(s.repo.Update(ctx, &entity.User{Id: "first", FirstName: "Olim", PhoneNumber: "+998901111111"}, nil))
+	user, err := s.repo.Get(ctx, map[string]string{"id": "first"})
+	s.Require().NoError(err)
+	s.Equal("Olim", user.FirstName)
+
+	_, err = s.repo.ChangePassword(ctx, &entity.ChangeUserPasswordReq{PhoneNumber: "+998901111111", Password: "new"})
+	s.Require().NoError(err)
+	user, err = s.repo.Get(ctx, map[string]string{"id": "first"})
+	s.Require().NoError(err)
+	s.Equal("new", user.Password)
+
+	s.Equal(3, s.users.reads)
+	s.Equal([]*entity.CacheInvalidated{
+		{EntityType: entity.AuditEntityUser, EntityId: "first"},
+		{EntityType: entity.AuditEntityUser, EntityId: "first"},
+	}, s.publisher.events)
+}
+
+func (s *CachedTestSuite) TestRecomputeWorkYears() {
+	ctx := context.Background()
+	admins := &adminStorageStub{admin: entity.Admin{Id: "admin", WorkYears: 3}}
+	repo := NewAdminRepo(admins, s.cache, s.publisher, time.Minute, time.Minute)
+
+	_, err := repo.Get(ctx, map[string]string{"id": "admin"})
+	s.Require().NoError(err)
+	ids, err := repo.RecomputeWorkYears(ctx)
+	s.Require().NoError(err)
+	s.Equal([]string{"admin"}, ids)
+
+	admin, err := repo.Get(ctx, map[string]string{"id": "admin"})
+	s.Require().NoError(err)
+	s.Equal(uint64(4), admin.WorkYears)
+	s.Equal([]*entity.CacheInvalidated{{EntityType: entity.AuditEntityAdmin, EntityId: "admin"}}, s.publisher.events)
+}
+
+func (s *CachedTestSuite) TestFailingCache() {
+	repo := NewUserRepo(s.users, failingCache{}, nil, time.Minute, time.Minute)
+	ctx := context.Background()
+
+	user, err := repo.Get(ctx, map[string]string{"id": "first"})
+	s.Require().NoError(err)
+	s.Equal("Ali", user.FirstName)
+	s.NoError(repo.Update(ctx, user, nil))
+}
+
+func TestCachedTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedTestSuite))
+}
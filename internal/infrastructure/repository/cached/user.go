@@ -0,0 +1,163 @@
+package cached
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/cache"
+	"time"
+)
+
+type userRepo struct {
+	repository.UserStorageI
+	lookup lookup
+}
+
+// NewUserRepo caches Get of users by id for ttl and missing users for notFoundTTL, publisher may be
+// nil when a single replica runs
+func NewUserRepo(repo repository.UserStorageI, cache cache.Cache, publisher Publisher, ttl, notFoundTTL time.Duration) *userRepo {
+	return &userRepo{
+		UserStorageI: repo,
+		lookup: lookup{
+			cache:       cache,
+			publisher:   publisher,
+			entityType:  entity.AuditEntityUser,
+			ttl:         ttl,
+			notFoundTTL: notFoundTTL,
+		},
+	}
+}
+
+// Get caches users without their password hash and refresh token, a cached user is served with
+// the password read from the database
+func (r *userRepo) Get(ctx context.Context, params map[string]string) (*entity.User, error) {
+	id, ok := cachedGet(params)
+	if !ok {
+		return r.UserStorageI.Get(ctx, params)
+	}
+
+	var user entity.User
+	if found, err := r.lookup.read(ctx, id, &user); found {
+		if err != nil {
+			return nil, err
+		}
+		if user.Password, err = r.UserStorageI.GetPassword(ctx, id); err != nil {
+			return nil, err
+		}
+		return &user, nil
+	}
+
+	loaded, err := r.UserStorageI.Get(ctx, params)
+	if err != nil {
+		r.lookup.write(ctx, id, nil, err)
+		return nil, err
+	}
+	cached := *loaded
+	cached.Password, cached.RefreshToken = "", ""
+	r.lookup.write(ctx, id, &cached, nil)
+
+	return loaded, nil
+}
+
+// Create drops a cached miss of the new user
+func (r *userRepo) Create(ctx context.Context, user *entity.User) error {
+	if err := r.UserStorageI.Create(ctx, user); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, user.Id)
+
+	return nil
+}
+
+func (r *userRepo) CopyUsers(ctx context.Context, users []*entity.User) (int64, error) {
+	copied, err := r.UserStorageI.CopyUsers(ctx, users)
+	if err != nil {
+		return copied, err
+	}
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = user.Id
+	}
+	r.lookup.invalidate(ctx, ids...)
+
+	return copied, nil
+}
+
+func (r *userRepo) Update(ctx context.Context, user *entity.User, fields []string) error {
+	if err := r.UserStorageI.Update(ctx, user, fields); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, user.Id)
+
+	return nil
+}
+
+func (r *userRepo) Delete(ctx context.Context, id string) error {
+	if err := r.UserStorageI.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return nil
+}
+
+func (r *userRepo) ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error) {
+	resp, err := r.UserStorageI.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status {
+		r.lookup.invalidate(ctx, resp.Id)
+	}
+
+	return resp, nil
+}
+
+func (r *userRepo) Restore(ctx context.Context, id string) error {
+	if err := r.UserStorageI.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return nil
+}
+
+func (r *userRepo) Erase(ctx context.Context, id string) (time.Time, error) {
+	erasedAt, err := r.UserStorageI.Erase(ctx, id)
+	if err != nil {
+		return erasedAt, err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return erasedAt, nil
+}
+
+// Merge invalidates the survivor too, its session may have been carried over
+func (r *userRepo) Merge(ctx context.Context, survivorId, mergedId string) (time.Time, error) {
+	mergedAt, err := r.UserStorageI.Merge(ctx, survivorId, mergedId)
+	if err != nil {
+		return mergedAt, err
+	}
+	r.lookup.invalidate(ctx, survivorId, mergedId)
+
+	return mergedAt, nil
+}
+
+func (r *userRepo) SetStatus(ctx context.Context, user *entity.User) error {
+	if err := r.UserStorageI.SetStatus(ctx, user); err != nil {
+		return err
+	}
+	r.lookup.invalidate(ctx, user.Id)
+
+	return nil
+}
+
+func (r *userRepo) VerifyEmail(ctx context.Context, tokenHash string) (string, error) {
+	id, err := r.UserStorageI.VerifyEmail(ctx, tokenHash)
+	if err != nil {
+		return id, err
+	}
+	r.lookup.invalidate(ctx, id)
+
+	return id, nil
+}
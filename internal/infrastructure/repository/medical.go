@@ -0,0 +1,11 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type MedicalStorageI interface {
+	Get(ctx context.Context, userId string) (*entity.MedicalProfile, error)
+	Save(ctx context.Context, profile *entity.MedicalProfile) error
+}
@@ -6,9 +6,12 @@ import (
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/pkg/postgres"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -29,34 +32,107 @@ func NewAdminRepo(db *postgres.PostgresDB) *adminRepo {
 	}
 }
 
+var adminColumns = []string{
+	"id",
+	"admin_order",
+	"role",
+	"first_name",
+	"last_name",
+	"birth_date",
+	"phone_number",
+	"email",
+	"password",
+	"gender",
+	"salary",
+	"biography",
+	"start_work_year",
+	"end_work_year",
+	"work_years",
+	"version",
+	"created_at",
+	"updated_at",
+	"terminated_at",
+}
+
+// adminSelectQueryPrefix selects active admins, invited admins are pending until they accept
 func (p *adminRepo) adminSelectQueryPrefix() squirrel.SelectBuilder {
 	return p.db.Sq.Builder.
-		Select(
-			"id",
-			"admin_order",
-			"role",
-			"first_name",
-			"last_name",
-			"birth_date",
-			"phone_number",
-			"email",
-			"password",
-			"gender",
-			"salary",
-			"biography",
-			"start_work_year",
-			"end_work_year",
-			"work_years",
-			"created_at",
-			"updated_at",
-		).From(p.tableName).
-		Where("deleted_at IS NULL")
+		Select(adminColumns...).
+		From(p.tableName).
+		Where("deleted_at IS NULL").
+		Where("activated_at IS NOT NULL")
 }
 
-func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
+// adminFilter applies the filters of listing, unknown keys are ignored
+func (p *adminRepo) adminFilter(queryBuilder squirrel.SelectBuilder, filter map[string]string) squirrel.SelectBuilder {
+	for key, value := range filter {
+		if key == "id" {
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+			continue
+		}
+		if key == "created_at" {
+			queryBuilder = queryBuilder.Where("created_at=?", value)
+			continue
+		}
+	}
+	return queryBuilder
+}
+
+func (p *adminRepo) deletedAdminSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(append(adminColumns, "deleted_at")...).
+		From(p.tableName).
+		Where("deleted_at IS NOT NULL")
+}
+
+func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) (err error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Create")
-	defer span.End()
-	data := map[string]any{
+	defer func() { span.EndError(err) }()
+
+	data := adminInsertData(admin)
+	data["activated_at"] = squirrel.Expr("NOW()")
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	if err = insertSalaryChange(ctx, tx, admin.Id, admin.Salary); err != nil {
+		return p.db.Error(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// insertSalaryChange appends to the salary history, it runs in the transaction writing admins.salary
+func insertSalaryChange(ctx context.Context, tx pgx.Tx, adminId string, salary float32) error {
+	_, err := tx.Exec(ctx, `INSERT INTO admin_salary_history (admin_id, salary) VALUES ($1, $2)`, adminId, salary)
+	return err
+}
+
+// nullDate stores an empty date as NULL, an admin without end_work_year is still employed
+func nullDate(date string) any {
+	if date == "" {
+		return nil
+	}
+	return date
+}
+
+func adminInsertData(admin *entity.Admin) map[string]any {
+	return map[string]any{
 		"id":              admin.Id,
 		"role":            admin.Role,
 		"first_name":      admin.FirstName,
@@ -69,28 +145,16 @@ func (p adminRepo) Create(ctx context.Context, admin *entity.Admin) error {
 		"salary":          admin.Salary,
 		"biography":       admin.Biography,
 		"start_work_year": admin.StartWorkYear,
-		"end_work_year":   admin.EndWorkYear,
+		"end_work_year":   nullDate(admin.EndWorkYear),
 		"work_years":      admin.WorkYears,
 		"refresh_token":   admin.RefreshToken,
 		"created_at":      admin.CreatedAt,
 	}
-
-	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
-	if err != nil {
-		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
-	}
-
-	_, err = p.db.Exec(ctx, query, args...)
-	if err != nil {
-		return p.db.Error(err)
-	}
-
-	return nil
 }
 
-func (p adminRepo) Get(ctx context.Context, params map[string]string) (*entity.Admin, error) {
+func (p adminRepo) Get(ctx context.Context, params map[string]string) (_ *entity.Admin, err error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Get")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	var (
 		admin entity.Admin
@@ -107,10 +171,12 @@ func (p adminRepo) Get(ctx context.Context, params map[string]string) (*entity.A
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
 	var (
 		birthDate       sql.NullString
 		updatedAt       sql.NullTime
+		terminatedAt    sql.NullTime
 		start_work_year sql.NullString
 		end_work_year   sql.NullString
 	)
@@ -130,8 +196,10 @@ func (p adminRepo) Get(ctx context.Context, params map[string]string) (*entity.A
 		&start_work_year,
 		&end_work_year,
 		&admin.WorkYears,
+		&admin.Version,
 		&admin.CreatedAt,
 		&updatedAt,
+		&terminatedAt,
 	); err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -139,6 +207,9 @@ func (p adminRepo) Get(ctx context.Context, params map[string]string) (*entity.A
 	if updatedAt.Valid {
 		admin.UpdatedAt = updatedAt.Time
 	}
+	if terminatedAt.Valid {
+		admin.TerminatedAt = terminatedAt.Time
+	}
 	if birthDate.Valid {
 		admin.BirthDate = birthDate.String
 	}
@@ -152,9 +223,34 @@ func (p adminRepo) Get(ctx context.Context, params map[string]string) (*entity.A
 	return &admin, nil
 }
 
-func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Admin, error) {
+// GetPassword reads the password hash of an admin by id, the cache keeps admins without it
+func (p *adminRepo) GetPassword(ctx context.Context, id string) (_ string, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"GetPassword")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("password").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").
+		Where("activated_at IS NOT NULL").
+		ToSql()
+	if err != nil {
+		return "", p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get password"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var password string
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&password); err != nil {
+		return "", p.db.Error(err)
+	}
+
+	return password, nil
+}
+
+func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.Admin, err error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"List")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	var (
 		admins []*entity.Admin
@@ -165,21 +261,13 @@ func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[st
 		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
 	}
 
-	for key, value := range filter {
-		if key == "id" {
-			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
-			continue
-		}
-		if key == "created_at" {
-			queryBuilder = queryBuilder.Where("created_at=?", value)
-			continue
-		}
-	}
+	queryBuilder = p.adminFilter(queryBuilder, filter)
 
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
@@ -189,6 +277,7 @@ func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[st
 	var (
 		birthDate       sql.NullString
 		updatedAt       sql.NullTime
+		terminatedAt    sql.NullTime
 		start_work_year sql.NullString
 		end_work_year   sql.NullString
 	)
@@ -210,8 +299,10 @@ func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[st
 			&start_work_year,
 			&end_work_year,
 			&admin.WorkYears,
+			&admin.Version,
 			&admin.CreatedAt,
 			&updatedAt,
+			&terminatedAt,
 		); err != nil {
 			return nil, p.db.Error(err)
 		}
@@ -219,6 +310,9 @@ func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[st
 		if updatedAt.Valid {
 			admin.UpdatedAt = updatedAt.Time
 		}
+		if terminatedAt.Valid {
+			admin.TerminatedAt = terminatedAt.Time
+		}
 		if birthDate.Valid {
 			admin.BirthDate = birthDate.String
 		}
@@ -230,13 +324,16 @@ func (p adminRepo) List(ctx context.Context, limit, offset uint64, filter map[st
 		}
 		admins = append(admins, &admin)
 	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(admins))))
 
 	return admins, nil
 }
 
-func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin) error {
+// Update writes the listed fields of admin only if its version still matches the stored one
+// and bumps the version, a stale version fails with entity.ErrPreconditionFailed
+func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin, fields []string) (err error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Update")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	clauses := map[string]interface{}{
 		"role":            admin.Role,
@@ -245,54 +342,98 @@ func (p *adminRepo) Update(ctx context.Context, admin *entity.Admin) error {
 		"birth_date":      admin.BirthDate,
 		"phone_number":    admin.PhoneNumber,
 		"email":           admin.Email,
-		"password":        admin.Password,
 		"gender":          admin.Gender,
 		"salary":          admin.Salary,
 		"biography":       admin.Biography,
 		"start_work_year": admin.StartWorkYear,
-		"end_work_year":   admin.EndWorkYear,
+		"end_work_year":   nullDate(admin.EndWorkYear),
 		"work_years":      admin.WorkYears,
-		"updated_at":      admin.UpdatedAt,
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at": admin.UpdatedAt,
+		"version":    squirrel.Expr("version + 1"),
+	}
+	for _, field := range fields {
+		value, ok := clauses[field]
+		if !ok {
+			return fmt.Errorf("admin field %s can not be updated", field)
+		}
+		setMap[field] = value
 	}
 
 	updateBuilder := p.db.Sq.Builder.
 		Update(p.tableName).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", admin.Id))
+		SetMap(setMap).
+		Where(p.db.Sq.Equal("id", admin.Id)).
+		Where(p.db.Sq.Equal("version", admin.Version))
 
-	updateBuilder = updateBuilder.Where("deleted_at IS NULL")
+	updateBuilder = updateBuilder.Where("deleted_at IS NULL").Suffix("RETURNING version")
 
 	sqlStr, args, err := updateBuilder.ToSql()
 	if err != nil {
 		return p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
+	tx, err := p.db.Begin(ctx)
 	if err != nil {
-		return p.db.Error(err)
+		return err
 	}
+	defer tx.Rollback(ctx)
 
-	if commandTag.RowsAffected() == 0 {
-		return p.db.Error(fmt.Errorf("no sql rows"))
+	var version uint64
+	if err = tx.QueryRow(ctx, sqlStr, args...).Scan(&version); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return p.db.Error(err)
+		}
+		return p.updateMissed(ctx, admin.Id)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	if _, ok := setMap["salary"]; ok {
+		if err = insertSalaryChange(ctx, tx, admin.Id, admin.Salary); err != nil {
+			return p.db.Error(err)
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return err
 	}
+	admin.Version = version
 
 	return nil
 }
 
-func (p *adminRepo) Delete(ctx context.Context, id string) error {
+// updateMissed tells a deleted or unknown admin apart from one updated by someone else
+func (p *adminRepo) updateMissed(ctx context.Context, id string) error {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND deleted_at IS NULL)`, p.tableName)
+	if err := p.db.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return p.db.Error(err)
+	}
+
+	if exists {
+		return entity.NewErrPreconditionFailed("admin")
+	}
+	return entity.NewErrNotFound("admin")
+}
+
+func (p *adminRepo) Delete(ctx context.Context, id string) (err error) {
 	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Delete")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	sqlStr := fmt.Sprintf(`
 		UPDATE %s
 		SET deleted_at = NOW()
 		WHERE id = $1 AND deleted_at IS NULL
 	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
 
 	commandTag, err := p.db.Exec(ctx, sqlStr, id)
 	if err != nil {
 		return p.db.Error(err)
 	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
 
 	if commandTag.RowsAffected() == 0 {
 		return p.db.Error(fmt.Errorf("no sql rows"))
@@ -301,12 +442,17 @@ func (p *adminRepo) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (p *adminRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
+func (p *adminRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (_ *entity.CheckFieldResp, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"CheckField")
+	defer func() { span.EndError(err) }()
+
 	query := fmt.Sprintf(`
 		SELECT count(1) 
 			FROM admins WHERE %s = $1 AND 
 			deleted_at IS NULL`, req.Field)
 
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
 	var isExists int
 
 	row := p.db.QueryRow(ctx, query, req.Value)
@@ -326,6 +472,9 @@ func (p *adminRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (
 }
 
 func (p *adminRepo) IfExists(ctx context.Context, req *entity.IfAdminExistsReq) (resp *entity.IfExistsResp, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"IfExists")
+	defer func() { span.EndError(err) }()
+
 	query := `
 		SELECT EXISTS(
 			SELECT 1 FROM admins
@@ -333,6 +482,8 @@ func (p *adminRepo) IfExists(ctx context.Context, req *entity.IfAdminExistsReq)
 			AND deleted_at IS NULL
 		)
 	`
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
 	var exists bool
 	row := p.db.QueryRow(ctx, query, req.PhoneNumber, req.Email)
 	if err = row.Scan(&exists); err != nil {
@@ -345,40 +496,195 @@ func (p *adminRepo) IfExists(ctx context.Context, req *entity.IfAdminExistsReq)
 	return resp, nil
 }
 
-func (p *adminRepo) ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error) {
+func (p *adminRepo) ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (_ *entity.ChangeAdminPasswordResp, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"ChangePassword")
+	defer func() { span.EndError(err) }()
+
 	query := `
 		UPDATE admins
-		SET password = $1
+		SET password = $1, version = version + 1
 		WHERE (email = $2 OR phone_number = $3)
 		AND deleted_at IS NULL
+		AND activated_at IS NOT NULL
+		AND terminated_at IS NULL
+		RETURNING id
 	`
 
-	resp, err := p.db.Exec(ctx, query, req.Password, req.Email, req.PhoneNumber)
-	if err != nil {
-		return nil, err
-	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
-	if resp.RowsAffected() == 0 {
-		return &entity.ChangeAdminPasswordResp{Status: false}, nil
+	var id string
+	if err = p.db.QueryRow(ctx, query, req.Password, req.Email, req.PhoneNumber).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &entity.ChangeAdminPasswordResp{Status: false}, nil
+		}
+		return nil, err
 	}
+	span.SetAttributes(otlp.RowsAffected(1))
 
-	return &entity.ChangeAdminPasswordResp{Status: true}, nil
+	return &entity.ChangeAdminPasswordResp{Id: id, Status: true}, nil
 }
 
-func (p *adminRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
+func (p *adminRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (_ *entity.UpdateRefreshTokenResp, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"UpdateRefreshToken")
+	defer func() { span.EndError(err) }()
+
 	query := `
 			UPDATE admins 
 			SET refresh_token = $1 
 			WHERE id = $2 AND 
-			deleted_at IS NULL`
+			deleted_at IS NULL AND
+			activated_at IS NOT NULL AND
+			terminated_at IS NULL`
+
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
 	resp, err := p.db.Exec(ctx, query, req.RefreshToken, req.Id)
 	if err != nil {
 		return nil, err
 	}
+	span.SetAttributes(otlp.RowsAffected(resp.RowsAffected()))
 	if resp.RowsAffected() == 0 {
 		return &entity.UpdateRefreshTokenResp{Status: false}, nil
 	}
 
 	return &entity.UpdateRefreshTokenResp{Status: true}, nil
 }
+
+func (p adminRepo) ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.Admin, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"ListDeleted")
+	defer func() { span.EndError(err) }()
+
+	var (
+		admins []*entity.Admin
+	)
+	queryBuilder := p.deletedAdminSelectQueryPrefix().OrderBy("deleted_at DESC")
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		switch key {
+		case "phone_number", "email":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		case "deleted_after":
+			queryBuilder = queryBuilder.Where("deleted_at >= ?", value)
+		case "deleted_before":
+			queryBuilder = queryBuilder.Where("deleted_at < ?", value)
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list deleted"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			admin           entity.Admin
+			birthDate       sql.NullString
+			updatedAt       sql.NullTime
+			terminatedAt    sql.NullTime
+			start_work_year sql.NullString
+			end_work_year   sql.NullString
+		)
+		if err = rows.Scan(
+			&admin.Id,
+			&admin.AdminOrder,
+			&admin.Role,
+			&admin.FirstName,
+			&admin.LastName,
+			&birthDate,
+			&admin.PhoneNumber,
+			&admin.Email,
+			&admin.Password,
+			&admin.Gender,
+			&admin.Salary,
+			&admin.Biography,
+			&start_work_year,
+			&end_work_year,
+			&admin.WorkYears,
+			&admin.Version,
+			&admin.CreatedAt,
+			&updatedAt,
+			&terminatedAt,
+			&admin.DeletedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		if updatedAt.Valid {
+			admin.UpdatedAt = updatedAt.Time
+		}
+		if terminatedAt.Valid {
+			admin.TerminatedAt = terminatedAt.Time
+		}
+		if birthDate.Valid {
+			admin.BirthDate = birthDate.String
+		}
+		if start_work_year.Valid {
+			admin.StartWorkYear = start_work_year.String
+		}
+		if end_work_year.Valid {
+			admin.EndWorkYear = end_work_year.String
+		}
+		admins = append(admins, &admin)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(admins))))
+
+	return admins, nil
+}
+
+// Restore undoes a soft delete, it fails with a conflict when the phone number
+// was taken by another admin in the meantime
+func (p *adminRepo) Restore(ctx context.Context, id string) (err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Restore")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NULL, updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, id)
+	if err != nil {
+		if err = p.db.Error(err); errors.Is(err, entity.ErrorConflict) {
+			return entity.NewErrConflict("admin with this phone number")
+		}
+		return err
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("deleted admin")
+	}
+
+	return nil
+}
+
+// Purge erases admins soft-deleted longer than retention ago
+func (p *adminRepo) Purge(ctx context.Context, retention time.Duration) (_ int64, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Purge")
+	defer func() { span.EndError(err) }()
+
+	// deleted_at is written with NOW(), so the cutoff uses the database clock as well
+	sqlStr := fmt.Sprintf(`DELETE FROM %s WHERE deleted_at IS NOT NULL AND deleted_at < NOW() - $1::interval`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, retention)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	return commandTag.RowsAffected(), nil
+}
@@ -33,7 +33,10 @@ func NewAdminService(ctxTimeout time.Duration, repo repo.AdminStorageI, config *
 // test func
 func (s *AdminReposisitoryTestSuite) TestAdminCRUD() {
 
-	config := config.New()
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
 
 	db, err := postgres.New(config)
 	if err != nil {
@@ -101,16 +104,32 @@ func (s *AdminReposisitoryTestSuite) TestAdminCRUD() {
 	s.Suite.Equal(getAdmin.FirstName, admin.FirstName)
 	s.Suite.Equal(getAdmin.PhoneNumber, admin.PhoneNumber)
 
+	// check get password method
+	password, err := adminRepo.GetPassword(ctx, admin.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(getAdmin.Password, password)
+
 	// check update admin method
-	err = adminRepo.Update(ctx, &updAdmin)
+	updAdmin.Version = getAdmin.Version
+	err = adminRepo.Update(ctx, &updAdmin, []string{"role", "first_name", "last_name", "phone_number"})
 	s.Suite.NoError(err)
+	s.Suite.Equal(getAdmin.Version+1, updAdmin.Version)
 	updGetAdmin, err := adminRepo.Get(ctx, Params)
 	s.Suite.NoError(err)
 	s.Suite.NotNil(updGetAdmin)
+	s.Suite.Equal(updAdmin.Version, updGetAdmin.Version)
+
+	// check update with a stale version
+	stale := updAdmin
+	stale.Version = getAdmin.Version
+	err = adminRepo.Update(ctx, &stale, []string{"first_name"})
+	var errPrecondition *entity.ErrPreconditionFailed
+	s.Suite.ErrorAs(err, &errPrecondition)
 	s.Suite.Equal(updGetAdmin.Id, updAdmin.Id)
 	s.Suite.Equal(updGetAdmin.FirstName, updAdmin.FirstName)
 	s.Suite.Equal(updGetAdmin.PhoneNumber, updAdmin.PhoneNumber)
 	s.Suite.Equal(updGetAdmin.Role, updAdmin.Role)
+	s.Suite.Equal(updGetAdmin.Email, admin.Email)
 
 	// // check getAllAdmins method
 	getAllAdmins, err := adminRepo.List(ctx, 5, 1, nil)
@@ -147,6 +166,7 @@ func (s *AdminReposisitoryTestSuite) TestAdminCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(resp_change_password)
 	s.Suite.Equal(resp_change_password.Status, true)
+	s.Suite.Equal(resp_change_password.Id, updAdmin.Id)
 
     // check ChangePassword user method for Email change
 	change_password_req_2 := entity.ChangeAdminPasswordReq{
@@ -172,6 +192,24 @@ func (s *AdminReposisitoryTestSuite) TestAdminCRUD() {
 	err = adminRepo.Delete(ctx, admin.Id)
 	s.Suite.NoError(err)
 
+	// check listDeleted admin method
+	deleted, err := adminRepo.ListDeleted(ctx, 10, 0, map[string]string{"phone_number": updAdmin.PhoneNumber})
+	s.Suite.NoError(err)
+	s.Suite.NotEmpty(deleted)
+	s.Suite.Equal(deleted[0].Id, admin.Id)
+	s.Suite.False(deleted[0].DeletedAt.IsZero())
+
+	// check restore admin method
+	err = adminRepo.Restore(ctx, admin.Id)
+	s.Suite.NoError(err)
+	_, err = adminRepo.Get(ctx, Params)
+	s.Suite.NoError(err)
+	err = adminRepo.Restore(ctx, admin.Id)
+	var errNotFound *entity.ErrNotFound
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	err = adminRepo.Delete(ctx, admin.Id)
+	s.Suite.NoError(err)
 }
 
 func TestExampleAdminTestSuite(t *testing.T) {
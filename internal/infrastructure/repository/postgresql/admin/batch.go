@@ -0,0 +1,85 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"fmt"
+)
+
+// GetMany returns the active admins among ids with a single query, in no particular order,
+// ids must be valid uuids and the ids of missing admins are left out
+func (p *adminRepo) GetMany(ctx context.Context, ids []string) (_ []*entity.Admin, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"GetMany")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.adminSelectQueryPrefix().
+		Where("id = ANY(?::uuid[])", ids).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get many"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var admins []*entity.Admin
+	for rows.Next() {
+		var (
+			admin         entity.Admin
+			birthDate     sql.NullString
+			updatedAt     sql.NullTime
+			terminatedAt  sql.NullTime
+			startWorkYear sql.NullString
+			endWorkYear   sql.NullString
+		)
+		if err = rows.Scan(
+			&admin.Id,
+			&admin.AdminOrder,
+			&admin.Role,
+			&admin.FirstName,
+			&admin.LastName,
+			&birthDate,
+			&admin.PhoneNumber,
+			&admin.Email,
+			&admin.Password,
+			&admin.Gender,
+			&admin.Salary,
+			&admin.Biography,
+			&startWorkYear,
+			&endWorkYear,
+			&admin.WorkYears,
+			&admin.Version,
+			&admin.CreatedAt,
+			&updatedAt,
+			&terminatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		if updatedAt.Valid {
+			admin.UpdatedAt = updatedAt.Time
+		}
+		if terminatedAt.Valid {
+			admin.TerminatedAt = terminatedAt.Time
+		}
+		if birthDate.Valid {
+			admin.BirthDate = birthDate.String
+		}
+		if startWorkYear.Valid {
+			admin.StartWorkYear = startWorkYear.String
+		}
+		if endWorkYear.Valid {
+			admin.EndWorkYear = endWorkYear.String
+		}
+		admins = append(admins, &admin)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(admins))))
+
+	return admins, rows.Err()
+}
@@ -0,0 +1,126 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const salaryHistoryTableName = "admin_salary_history"
+
+// Terminate ends the employment of an admin on endDate, it clears the refresh token
+// so the open sessions can not be renewed, and a terminated admin can not log in again
+func (p *adminRepo) Terminate(ctx context.Context, id, endDate string, workYears uint64) (_ time.Time, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Terminate")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET end_work_year = $1,
+			work_years = $2,
+			refresh_token = '',
+			terminated_at = NOW(),
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $3
+		AND deleted_at IS NULL
+		AND activated_at IS NOT NULL
+		AND terminated_at IS NULL
+		RETURNING terminated_at
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	var terminatedAt time.Time
+	if err = p.db.QueryRow(ctx, sqlStr, endDate, workYears, id).Scan(&terminatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, entity.NewErrNotFound("active admin")
+		}
+		return time.Time{}, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return terminatedAt, nil
+}
+
+// ListSalaryHistory returns the salaries of an admin, the newest first
+func (p *adminRepo) ListSalaryHistory(ctx context.Context, adminId string) (_ []*entity.SalaryChange, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"ListSalaryHistory")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("id", "admin_id", "salary", "created_at").
+		From(salaryHistoryTableName).
+		Where(p.db.Sq.Equal("admin_id", adminId)).
+		OrderBy("created_at DESC", "id DESC").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", salaryHistoryTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(salaryHistoryTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var changes []*entity.SalaryChange
+	for rows.Next() {
+		var change entity.SalaryChange
+		if err = rows.Scan(&change.Id, &change.AdminId, &change.Salary, &change.CreatedAt); err != nil {
+			return nil, p.db.Error(err)
+		}
+		changes = append(changes, &change)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(changes))))
+
+	return changes, rows.Err()
+}
+
+// RecomputeWorkYears refreshes work_years of the admins whose tenure grew since it was
+// last written, admins still employed count up to today. It returns the ids of the admins it changed
+func (p *adminRepo) RecomputeWorkYears(ctx context.Context) (_ []string, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"RecomputeWorkYears")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		WITH tenure AS (
+			SELECT id, GREATEST(EXTRACT(YEAR FROM age(COALESCE(end_work_year, CURRENT_DATE), start_work_year))::int, 0) AS years
+			FROM %[1]s
+			WHERE deleted_at IS NULL
+		)
+		UPDATE %[1]s a
+		SET work_years = tenure.years
+		FROM tenure
+		WHERE a.id = tenure.id
+		AND a.work_years IS DISTINCT FROM tenure.years
+		RETURNING a.id::text
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	rows, err := p.db.Query(ctx, sqlStr)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, p.db.Error(err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(ids))))
+
+	return ids, nil
+}
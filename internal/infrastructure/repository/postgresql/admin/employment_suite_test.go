@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmploymentRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *EmploymentRepositoryTestSuite) TestEmployment() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	adminRepo := NewAdminRepo(s.DB)
+	ctx := context.Background()
+
+	admin := entity.Admin{
+		Id:            uuid.New().String(),
+		Role:          "admin",
+		FirstName:     "employmentdata",
+		LastName:      "employmentdata",
+		BirthDate:     "2000-08-30",
+		PhoneNumber:   uuid.New().String(),
+		Email:         "employmentdata",
+		Password:      "employmentdata",
+		Gender:        "male",
+		Salary:        100,
+		StartWorkYear: "2010-08-30",
+		RefreshToken:  "employmentdata",
+		CreatedAt:     time.Now().UTC(),
+	}
+	s.Require().NoError(adminRepo.Create(ctx, &admin))
+
+	// check salary history, every salary change is kept
+	got, err := adminRepo.Get(ctx, map[string]string{"id": admin.Id})
+	s.Require().NoError(err)
+	got.Salary = 200
+	s.Suite.NoError(adminRepo.Update(ctx, got, []string{"salary"}))
+	history, err := adminRepo.ListSalaryHistory(ctx, admin.Id)
+	s.Suite.NoError(err)
+	s.Suite.Len(history, 2)
+	s.Suite.Equal(history[0].Salary, float32(200))
+	s.Suite.Equal(history[1].Salary, float32(100))
+
+	// check recompute work years method
+	_, err = adminRepo.RecomputeWorkYears(ctx)
+	s.Suite.NoError(err)
+	got, err = adminRepo.Get(ctx, map[string]string{"id": admin.Id})
+	s.Suite.NoError(err)
+	s.Suite.NotZero(got.WorkYears)
+
+	// check terminate method, the admin can no longer log in
+	terminatedAt, err := adminRepo.Terminate(ctx, admin.Id, "2020-08-30", 10)
+	s.Suite.NoError(err)
+	s.Suite.False(terminatedAt.IsZero())
+	var errNotFound *entity.ErrNotFound
+	_, err = adminRepo.Terminate(ctx, admin.Id, "2020-08-30", 10)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	terminated, err := adminRepo.Get(ctx, map[string]string{"id": admin.Id})
+	s.Suite.NoError(err)
+	s.Suite.False(terminated.TerminatedAt.IsZero())
+	s.Suite.Equal(terminated.WorkYears, uint64(10))
+
+	resp, err := adminRepo.ChangePassword(ctx, &entity.ChangeAdminPasswordReq{PhoneNumber: admin.PhoneNumber, Password: "new_password"})
+	s.Suite.NoError(err)
+	s.Suite.False(resp.Status)
+	refresh, err := adminRepo.UpdateRefreshToken(ctx, &entity.UpdateRefreshTokenReq{Id: admin.Id, RefreshToken: "new_refresh_token"})
+	s.Suite.NoError(err)
+	s.Suite.False(refresh.Status)
+
+	s.Suite.NoError(adminRepo.Delete(ctx, admin.Id))
+}
+
+func TestEmploymentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(EmploymentRepositoryTestSuite))
+}
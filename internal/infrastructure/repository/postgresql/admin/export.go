@@ -0,0 +1,68 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/otlp"
+	"fmt"
+)
+
+// exportBatch is how many rows an export fetches from its cursor at a time
+const exportBatch = 1000
+
+// adminExportColumns are the expressions of the exportable columns, passwords and tokens have none
+var adminExportColumns = map[string]string{
+	"id":              "id::text",
+	"admin_order":     "admin_order",
+	"role":            "role::text",
+	"first_name":      "first_name",
+	"last_name":       "last_name",
+	"birth_date":      "to_char(birth_date, 'YYYY-MM-DD')",
+	"phone_number":    "phone_number",
+	"email":           "email",
+	"gender":          "gender::text",
+	"salary":          "salary",
+	"biography":       "biography",
+	"start_work_year": "to_char(start_work_year, 'YYYY-MM-DD')",
+	"end_work_year":   "to_char(end_work_year, 'YYYY-MM-DD')",
+	"work_years":      "work_years",
+	"created_at":      "created_at",
+	"updated_at":      "updated_at",
+	"terminated_at":   "terminated_at",
+}
+
+// Export calls fn with the values of columns of every active admin matching the filters of listing,
+// in admin order, the admins are read through a cursor
+func (p *adminRepo) Export(ctx context.Context, columns []string, filter map[string]string, fn func([]any) error) (err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"Export")
+	defer func() { span.EndError(err) }()
+
+	expressions := make([]string, len(columns))
+	for i, column := range columns {
+		expression, ok := adminExportColumns[column]
+		if !ok {
+			return fmt.Errorf("column %s of %s can not be exported", column, p.tableName)
+		}
+		expressions[i] = expression + " AS " + column
+	}
+
+	queryBuilder := p.db.Sq.Builder.
+		Select(expressions...).
+		From(p.tableName).
+		Where("deleted_at IS NULL").
+		Where("activated_at IS NOT NULL").
+		OrderBy("admin_order")
+	query, args, err := p.adminFilter(queryBuilder, filter).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "export"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var exported int64
+	err = p.db.Cursor(ctx, "admin_export", exportBatch, query, args, func(values []any) error {
+		exported++
+		return fn(values)
+	})
+	span.SetAttributes(otlp.RowsAffected(exported))
+
+	return err
+}
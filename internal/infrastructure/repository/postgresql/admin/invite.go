@@ -0,0 +1,233 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const inviteTableName = "admin_invites"
+
+// CreateInvite inserts a pending admin and its invite in one transaction
+func (p *adminRepo) CreateInvite(ctx context.Context, admin *entity.Admin, invite *entity.AdminInvite) (err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"CreateInvite")
+	defer func() { span.EndError(err) }()
+
+	adminQuery, adminArgs, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(adminInsertData(admin)).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create invite"))
+	}
+	inviteQuery, inviteArgs, err := p.db.Sq.Builder.Insert(inviteTableName).
+		SetMap(map[string]any{
+			"id":         invite.Id,
+			"admin_id":   invite.AdminId,
+			"token_hash": invite.TokenHash,
+			"channel":    invite.Channel,
+			"recipient":  invite.Recipient,
+			"invited_by": invite.InvitedBy,
+			"expires_at": invite.ExpiresAt,
+		}).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", inviteTableName, "create"))
+	}
+	span.SetAttributes(otlp.DBAttributes(inviteTableName, inviteQuery)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, adminQuery, adminArgs...); err != nil {
+		if errors.Is(p.db.Error(err), entity.ErrorConflict) {
+			return entity.NewErrConflict("admin with this phone number")
+		}
+		return p.db.Error(err)
+	}
+	if err = tx.QueryRow(ctx, inviteQuery, inviteArgs...).Scan(&invite.CreatedAt); err != nil {
+		return p.db.Error(err)
+	}
+	if err = insertSalaryChange(ctx, tx, admin.Id, admin.Salary); err != nil {
+		return p.db.Error(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// AcceptInvite uses up a pending invite and activates its admin with password
+func (p *adminRepo) AcceptInvite(ctx context.Context, tokenHash, password string) (_ string, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"AcceptInvite")
+	defer func() { span.EndError(err) }()
+
+	inviteQuery := `
+		UPDATE admin_invites
+		SET accepted_at = NOW()
+		WHERE token_hash = $1
+		AND accepted_at IS NULL
+		AND revoked_at IS NULL
+		AND expires_at > NOW()
+		RETURNING admin_id
+	`
+	adminQuery := `
+		UPDATE admins
+		SET password = $1, activated_at = NOW(), version = version + 1
+		WHERE id = $2
+		AND activated_at IS NULL
+		AND deleted_at IS NULL
+	`
+	span.SetAttributes(otlp.DBAttributes(inviteTableName, inviteQuery)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	var adminId string
+	if err = tx.QueryRow(ctx, inviteQuery, tokenHash).Scan(&adminId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", entity.NewErrNotFound("pending invite")
+		}
+		return "", p.db.Error(err)
+	}
+
+	commandTag, err := tx.Exec(ctx, adminQuery, password, adminId)
+	if err != nil {
+		return "", p.db.Error(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return "", entity.NewErrNotFound("pending admin")
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	return adminId, tx.Commit(ctx)
+}
+
+// ListInvites returns the newest invites first, filter keys are admin_id and status
+func (p *adminRepo) ListInvites(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.AdminInvite, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"ListInvites")
+	defer func() { span.EndError(err) }()
+
+	queryBuilder := p.db.Sq.Builder.
+		Select(
+			"id",
+			"admin_id",
+			"channel",
+			"recipient",
+			"invited_by",
+			"expires_at",
+			"accepted_at",
+			"revoked_at",
+			"created_at",
+		).
+		From(inviteTableName).
+		OrderBy("created_at DESC")
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		switch key {
+		case "admin_id":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		case "status":
+			switch value {
+			case entity.InviteStatusPending:
+				queryBuilder = queryBuilder.Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()")
+			case entity.InviteStatusAccepted:
+				queryBuilder = queryBuilder.Where("accepted_at IS NOT NULL")
+			case entity.InviteStatusRevoked:
+				queryBuilder = queryBuilder.Where("revoked_at IS NOT NULL")
+			case entity.InviteStatusExpired:
+				queryBuilder = queryBuilder.Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at <= NOW()")
+			}
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", inviteTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(inviteTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var invites []*entity.AdminInvite
+	for rows.Next() {
+		var (
+			invite     entity.AdminInvite
+			acceptedAt sql.NullTime
+			revokedAt  sql.NullTime
+		)
+		if err = rows.Scan(
+			&invite.Id,
+			&invite.AdminId,
+			&invite.Channel,
+			&invite.Recipient,
+			&invite.InvitedBy,
+			&invite.ExpiresAt,
+			&acceptedAt,
+			&revokedAt,
+			&invite.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if acceptedAt.Valid {
+			invite.AcceptedAt = acceptedAt.Time
+		}
+		if revokedAt.Valid {
+			invite.RevokedAt = revokedAt.Time
+		}
+		invites = append(invites, &invite)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(invites))))
+
+	return invites, rows.Err()
+}
+
+// RevokeInvite cancels an invite that was not accepted and removes its pending admin
+func (p *adminRepo) RevokeInvite(ctx context.Context, id string) (_ string, err error) {
+	ctx, span := otlp.Start(ctx, adminServiceName, adminSpanRepoPrefix+"RevokeInvite")
+	defer func() { span.EndError(err) }()
+
+	inviteQuery := `
+		UPDATE admin_invites
+		SET revoked_at = NOW()
+		WHERE id = $1
+		AND accepted_at IS NULL
+		AND revoked_at IS NULL
+		RETURNING admin_id
+	`
+	span.SetAttributes(otlp.DBAttributes(inviteTableName, inviteQuery)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	var adminId string
+	if err = tx.QueryRow(ctx, inviteQuery, id).Scan(&adminId); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", entity.NewErrNotFound("pending invite")
+		}
+		return "", p.db.Error(err)
+	}
+
+	if _, err = tx.Exec(ctx, `DELETE FROM admins WHERE id = $1 AND activated_at IS NULL`, adminId); err != nil {
+		return "", p.db.Error(err)
+	}
+
+	return adminId, tx.Commit(ctx)
+}
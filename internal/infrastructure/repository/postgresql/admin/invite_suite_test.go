@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type InviteRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *InviteRepositoryTestSuite) TestInvites() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	adminRepo := NewAdminRepo(s.DB)
+	ctx := context.Background()
+
+	newInvite := func() (*entity.Admin, *entity.AdminInvite) {
+		admin := &entity.Admin{
+			Id:            uuid.New().String(),
+			Role:          "admin",
+			FirstName:     "invitedata",
+			LastName:      "invitedata",
+			BirthDate:     "2000-08-30",
+			PhoneNumber:   uuid.New().String(),
+			Email:         "invitedata",
+			Gender:        "male",
+			StartWorkYear: "2000-08-30",
+			CreatedAt:     time.Now().UTC(),
+		}
+		invite := &entity.AdminInvite{
+			Id:        uuid.New().String(),
+			AdminId:   admin.Id,
+			TokenHash: uuid.New().String(),
+			Channel:   entity.InviteChannelEmail,
+			Recipient: admin.Email,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+		return admin, invite
+	}
+
+	// check create invite method, the invited admin is pending
+	admin, invite := newInvite()
+	err = adminRepo.CreateInvite(ctx, admin, invite)
+	s.Suite.NoError(err)
+	var errNotFound *entity.ErrNotFound
+	_, err = adminRepo.Get(ctx, map[string]string{"id": admin.Id})
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	invites, err := adminRepo.ListInvites(ctx, 10, 0, map[string]string{
+		"admin_id": admin.Id,
+		"status":   entity.InviteStatusPending,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(invites, 1)
+
+	// check accept invite method, the token is single-use
+	adminId, err := adminRepo.AcceptInvite(ctx, invite.TokenHash, "invitepassword")
+	s.Suite.NoError(err)
+	s.Suite.Equal(adminId, admin.Id)
+	_, err = adminRepo.AcceptInvite(ctx, invite.TokenHash, "invitepassword")
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	activated, err := adminRepo.Get(ctx, map[string]string{"id": admin.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(activated.Password, "invitepassword")
+	_, err = adminRepo.RevokeInvite(ctx, invite.Id)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	// check revoke invite method, the pending admin is removed
+	revokedAdmin, revoked := newInvite()
+	s.Suite.NoError(adminRepo.CreateInvite(ctx, revokedAdmin, revoked))
+	adminId, err = adminRepo.RevokeInvite(ctx, revoked.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(adminId, revokedAdmin.Id)
+	_, err = adminRepo.AcceptInvite(ctx, revoked.TokenHash, "invitepassword")
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	invites, err = adminRepo.ListInvites(ctx, 10, 0, map[string]string{"admin_id": revokedAdmin.Id})
+	s.Suite.NoError(err)
+	s.Suite.Len(invites, 1)
+	s.Suite.Equal(invites[0].Status(time.Now()), entity.InviteStatusRevoked)
+
+	s.Suite.NoError(adminRepo.Delete(ctx, admin.Id))
+}
+
+func TestInviteRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteRepositoryTestSuite))
+}
@@ -0,0 +1,148 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	auditTableName      = "audit_log"
+	auditServiceName    = "auditService"
+	auditSpanRepoPrefix = "auditRepo"
+)
+
+type auditRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewAuditRepo(db *postgres.PostgresDB) *auditRepo {
+	return &auditRepo{
+		tableName: auditTableName,
+		db:        db,
+	}
+}
+
+func (p *auditRepo) auditSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(
+			"id",
+			"entity_type",
+			"entity_id",
+			"action",
+			"actor_id",
+			"actor_type",
+			"request_id",
+			"ip",
+			"changes",
+			"created_at",
+		).From(p.tableName)
+}
+
+func (p *auditRepo) Create(ctx context.Context, entry *entity.AuditEntry) (err error) {
+	ctx, span := otlp.Start(ctx, auditServiceName, auditSpanRepoPrefix+"Create")
+	defer func() { span.EndError(err) }()
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return fmt.Errorf("error during marshal audit changes: %w", err)
+	}
+
+	data := map[string]any{
+		"entity_type": entry.EntityType,
+		"entity_id":   entry.EntityId,
+		"action":      entry.Action,
+		"actor_id":    entry.ActorId,
+		"actor_type":  entry.ActorType,
+		"request_id":  entry.RequestId,
+		"ip":          entry.IP,
+		"changes":     string(changes),
+	}
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).Suffix("RETURNING id, created_at").ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&entry.Id, &entry.CreatedAt); err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// List returns the newest entries first, filter keys are entity_type, entity_id, actor_id,
+// created_after and created_before
+func (p *auditRepo) List(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.AuditEntry, err error) {
+	ctx, span := otlp.Start(ctx, auditServiceName, auditSpanRepoPrefix+"List")
+	defer func() { span.EndError(err) }()
+
+	var (
+		entries []*entity.AuditEntry
+	)
+	queryBuilder := p.auditSelectQueryPrefix().OrderBy("created_at DESC", "id DESC")
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		switch key {
+		case "entity_type", "entity_id", "actor_id", "action":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		case "created_after":
+			queryBuilder = queryBuilder.Where("created_at >= ?", value)
+		case "created_before":
+			queryBuilder = queryBuilder.Where("created_at < ?", value)
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			entry   entity.AuditEntry
+			changes []byte
+		)
+		if err = rows.Scan(
+			&entry.Id,
+			&entry.EntityType,
+			&entry.EntityId,
+			&entry.Action,
+			&entry.ActorId,
+			&entry.ActorType,
+			&entry.RequestId,
+			&entry.IP,
+			&changes,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		if err = json.Unmarshal(changes, &entry.Changes); err != nil {
+			return nil, fmt.Errorf("error during unmarshal audit changes: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(entries))))
+
+	return entries, nil
+}
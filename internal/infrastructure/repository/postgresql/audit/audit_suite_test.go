@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *AuditRepositoryTestSuite) TestAuditLog() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	auditRepo := NewAuditRepo(s.DB)
+	ctx := context.Background()
+
+	entityId := uuid.New().String()
+	created := entity.AuditEntry{
+		EntityType: entity.AuditEntityUser,
+		EntityId:   entityId,
+		Action:     entity.AuditActionCreate,
+		ActorType:  "admin",
+		RequestId:  uuid.New().String(),
+		IP:         "10.0.0.1",
+		Changes: map[string]entity.AuditChange{
+			"first_name": {After: "firstname"},
+		},
+	}
+	updated := entity.AuditEntry{
+		EntityType: entity.AuditEntityUser,
+		EntityId:   entityId,
+		Action:     entity.AuditActionUpdate,
+		Changes: map[string]entity.AuditChange{
+			"first_name": {Before: "firstname", After: "updfirstname"},
+		},
+	}
+
+	// check create audit method
+	err = auditRepo.Create(ctx, &created)
+	s.Suite.NoError(err)
+	s.Suite.NotZero(created.Id)
+	s.Suite.False(created.CreatedAt.IsZero())
+	err = auditRepo.Create(ctx, &updated)
+	s.Suite.NoError(err)
+
+	// check list audit method, newest entries come first
+	entries, err := auditRepo.List(ctx, 10, 0, map[string]string{"entity_id": entityId})
+	s.Suite.NoError(err)
+	s.Suite.Len(entries, 2)
+	s.Suite.Equal(entries[0].Action, entity.AuditActionUpdate)
+	s.Suite.Equal(entries[0].Changes, updated.Changes)
+	s.Suite.Equal(entries[1].RequestId, created.RequestId)
+
+	entries, err = auditRepo.List(ctx, 10, 0, map[string]string{
+		"entity_id": entityId,
+		"action":    entity.AuditActionCreate,
+	})
+	s.Suite.NoError(err)
+	s.Suite.Len(entries, 1)
+	s.Suite.Equal(entries[0].Id, created.Id)
+}
+
+func TestAuditRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditRepositoryTestSuite))
+}
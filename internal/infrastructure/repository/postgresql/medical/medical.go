@@ -0,0 +1,251 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/envelope"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	medicalTableName      = "medical_profiles"
+	medicalServiceName    = "medicalService"
+	medicalSpanRepoPrefix = "medicalRepo"
+)
+
+var errNoKey = errors.New("medical profiles are unavailable, no MEDICAL_KEY is configured")
+
+type medicalRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+	keyring   *envelope.Keyring
+}
+
+// NewMedicalRepo encrypts every profile with its own data key sealed by keyring,
+// a nil keyring makes every method fail
+func NewMedicalRepo(db *postgres.PostgresDB, keyring *envelope.Keyring) *medicalRepo {
+	return &medicalRepo{
+		tableName: medicalTableName,
+		db:        db,
+		keyring:   keyring,
+	}
+}
+
+// medicalColumns are the encrypted columns in the order they are written and read
+var medicalColumns = []string{"blood_type", "allergies", "chronic_conditions", "medications"}
+
+// encryptedProfile is a profile as it is stored
+type encryptedProfile struct {
+	keyId   string
+	dataKey []byte
+	values  [][]byte
+}
+
+// aad binds a ciphertext to its user and column
+func aad(userId, column string) []byte {
+	return []byte(userId + ":" + column)
+}
+
+func (p *medicalRepo) encrypt(profile *entity.MedicalProfile) (*encryptedProfile, error) {
+	if p.keyring == nil {
+		return nil, errNoKey
+	}
+
+	dataKey, sealedKey, err := p.keyring.NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+	encrypted := &encryptedProfile{keyId: p.keyring.KeyId(), dataKey: sealedKey}
+
+	plaintexts := [][]byte{[]byte(profile.BloodType)}
+	for _, list := range [][]string{profile.Allergies, profile.ChronicConditions, profile.Medications} {
+		if list == nil {
+			list = []string{}
+		}
+		plaintext, err := json.Marshal(list)
+		if err != nil {
+			return nil, err
+		}
+		plaintexts = append(plaintexts, plaintext)
+	}
+	for i, plaintext := range plaintexts {
+		ciphertext, err := dataKey.Seal(plaintext, aad(profile.UserId, medicalColumns[i]))
+		if err != nil {
+			return nil, err
+		}
+		encrypted.values = append(encrypted.values, ciphertext)
+	}
+
+	return encrypted, nil
+}
+
+func (p *medicalRepo) decrypt(encrypted *encryptedProfile, profile *entity.MedicalProfile) error {
+	if p.keyring == nil {
+		return errNoKey
+	}
+
+	dataKey, err := p.keyring.OpenDataKey(encrypted.keyId, encrypted.dataKey)
+	if err != nil {
+		return fmt.Errorf("medical profile of %s: %w", profile.UserId, err)
+	}
+
+	var plaintexts [][]byte
+	for i, ciphertext := range encrypted.values {
+		plaintext, err := dataKey.Open(ciphertext, aad(profile.UserId, medicalColumns[i]))
+		if err != nil {
+			return fmt.Errorf("medical profile of %s, %s: %w", profile.UserId, medicalColumns[i], err)
+		}
+		plaintexts = append(plaintexts, plaintext)
+	}
+
+	profile.BloodType = string(plaintexts[0])
+	for i, list := range []*[]string{&profile.Allergies, &profile.ChronicConditions, &profile.Medications} {
+		if err := json.Unmarshal(plaintexts[i+1], list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *medicalRepo) Get(ctx context.Context, userId string) (_ *entity.MedicalProfile, err error) {
+	ctx, span := otlp.Start(ctx, medicalServiceName, medicalSpanRepoPrefix+"Get")
+	defer func() { span.EndError(err) }()
+
+	if p.keyring == nil {
+		return nil, errNoKey
+	}
+
+	query, args, err := p.db.Sq.Builder.
+		Select("user_id", "key_id", "data_key").
+		Columns(medicalColumns...).
+		Columns("version", "updated_by", "created_at", "updated_at").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("user_id", userId)).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var (
+		profile   entity.MedicalProfile
+		encrypted = encryptedProfile{values: make([][]byte, len(medicalColumns))}
+		updatedAt sql.NullTime
+	)
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&profile.UserId,
+		&encrypted.keyId,
+		&encrypted.dataKey,
+		&encrypted.values[0],
+		&encrypted.values[1],
+		&encrypted.values[2],
+		&encrypted.values[3],
+		&profile.Version,
+		&profile.UpdatedBy,
+		&profile.CreatedAt,
+		&updatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("medical profile")
+		}
+		return nil, p.db.Error(err)
+	}
+	if updatedAt.Valid {
+		profile.UpdatedAt = updatedAt.Time
+	}
+
+	if err = p.decrypt(&encrypted, &profile); err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+// Save creates the profile when profile.Version is 0, otherwise it replaces the profile at that version,
+// every save seals the profile with a new data key
+func (p *medicalRepo) Save(ctx context.Context, profile *entity.MedicalProfile) (err error) {
+	ctx, span := otlp.Start(ctx, medicalServiceName, medicalSpanRepoPrefix+"Save")
+	defer func() { span.EndError(err) }()
+
+	encrypted, err := p.encrypt(profile)
+	if err != nil {
+		return err
+	}
+
+	if profile.Version == 0 {
+		query, args, err := p.db.Sq.Builder.Insert(p.tableName).
+			SetMap(map[string]any{
+				"user_id":            profile.UserId,
+				"key_id":             encrypted.keyId,
+				"data_key":           encrypted.dataKey,
+				"blood_type":         encrypted.values[0],
+				"allergies":          encrypted.values[1],
+				"chronic_conditions": encrypted.values[2],
+				"medications":        encrypted.values[3],
+				"updated_by":         profile.UpdatedBy,
+			}).
+			Suffix("RETURNING version, created_at").
+			ToSql()
+		if err != nil {
+			return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+		}
+		span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+		if err = p.db.QueryRow(ctx, query, args...).Scan(&profile.Version, &profile.CreatedAt); err != nil {
+			return p.db.Error(err)
+		}
+		span.SetAttributes(otlp.RowsAffected(1))
+		return nil
+	}
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET key_id = $1,
+			data_key = $2,
+			blood_type = $3,
+			allergies = $4,
+			chronic_conditions = $5,
+			medications = $6,
+			updated_by = $7,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE user_id = $8 AND version = $9
+		RETURNING version, created_at, updated_at
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	if err = p.db.QueryRow(ctx, sqlStr, encrypted.keyId, encrypted.dataKey,
+		encrypted.values[0], encrypted.values[1], encrypted.values[2], encrypted.values[3],
+		profile.UpdatedBy, profile.UserId, profile.Version).
+		Scan(&profile.Version, &profile.CreatedAt, &profile.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return p.updateMissed(ctx, profile.UserId)
+		}
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// updateMissed tells a stale version apart from a profile that does not exist
+func (p *medicalRepo) updateMissed(ctx context.Context, userId string) error {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE user_id = $1)`, p.tableName)
+	if err := p.db.QueryRow(ctx, query, userId).Scan(&exists); err != nil {
+		return p.db.Error(err)
+	}
+
+	if exists {
+		return entity.NewErrPreconditionFailed("medical profile")
+	}
+	return entity.NewErrNotFound("medical profile")
+}
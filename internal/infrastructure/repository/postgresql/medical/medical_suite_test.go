@@ -0,0 +1,95 @@
+package postgresql
+
+import (
+	"bytes"
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/envelope"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MedicalRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *MedicalRepositoryTestSuite) TestMedicalProfile() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	keyring, err := envelope.New("test", bytes.Repeat([]byte{7}, envelope.KeySize))
+	s.Require().NoError(err)
+	medicalRepo := NewMedicalRepo(s.DB, keyring)
+	ctx := context.Background()
+
+	userId := uuid.New().String()
+	_, err = s.DB.Exec(ctx, `INSERT INTO users (id, first_name, last_name, birth_date, phone_number, password, gender, created_at)
+		VALUES ($1, 'medicaldata', 'medicaldata', '2000-08-30', $2, 'medicaldata', 'male', $3)`,
+		userId, uuid.New().String(), time.Now().UTC())
+	s.Require().NoError(err)
+
+	profile := entity.MedicalProfile{
+		UserId:            userId,
+		BloodType:         "B-",
+		Allergies:         []string{"penicillin", "peanuts"},
+		ChronicConditions: []string{"asthma"},
+		UpdatedBy:         userId,
+	}
+
+	// check save and get medical profile methods
+	s.Require().NoError(medicalRepo.Save(ctx, &profile))
+	s.Suite.Equal(uint64(1), profile.Version)
+
+	stored, err := medicalRepo.Get(ctx, userId)
+	s.Suite.NoError(err)
+	s.Suite.Equal(profile.BloodType, stored.BloodType)
+	s.Suite.Equal(profile.Allergies, stored.Allergies)
+	s.Suite.Equal(profile.ChronicConditions, stored.ChronicConditions)
+	s.Suite.Empty(stored.Medications)
+
+	// the columns hold ciphertext only
+	var allergies []byte
+	s.Require().NoError(s.DB.QueryRow(ctx, "SELECT allergies FROM medical_profiles WHERE user_id = $1", userId).Scan(&allergies))
+	s.Suite.False(bytes.Contains(allergies, []byte("penicillin")))
+
+	// check versioned update
+	stored.Medications = []string{"salbutamol"}
+	s.Require().NoError(medicalRepo.Save(ctx, stored))
+	s.Suite.Equal(uint64(2), stored.Version)
+
+	var errPrecondition *entity.ErrPreconditionFailed
+	profile.Version = 1
+	s.Suite.ErrorAs(medicalRepo.Save(ctx, &profile), &errPrecondition)
+
+	// a key that did not seal the data can not open it
+	otherKeyring, err := envelope.New("other", bytes.Repeat([]byte{8}, envelope.KeySize))
+	s.Require().NoError(err)
+	_, err = NewMedicalRepo(s.DB, otherKeyring).Get(ctx, userId)
+	s.Suite.ErrorIs(err, envelope.ErrUnknownKey)
+
+	_, err = s.DB.Exec(ctx, "DELETE FROM users WHERE id = $1", userId)
+	s.Suite.NoError(err)
+
+	var errNotFound *entity.ErrNotFound
+	_, err = medicalRepo.Get(ctx, userId)
+	s.Suite.ErrorAs(err, &errNotFound)
+}
+
+func TestMedicalRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MedicalRepositoryTestSuite))
+}
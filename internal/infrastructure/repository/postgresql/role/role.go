@@ -0,0 +1,385 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/postgres"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	roleTableName        = "roles"
+	adminRolesTableName  = "admin_roles"
+	roleHistoryTableName = "admin_role_history"
+	roleServiceName      = "roleService"
+	roleSpanRepoPrefix   = "roleRepo"
+)
+
+type roleRepo struct {
+	tableName string
+	db        *postgres.PostgresDB
+}
+
+func NewRoleRepo(db *postgres.PostgresDB) *roleRepo {
+	return &roleRepo{
+		tableName: roleTableName,
+		db:        db,
+	}
+}
+
+var roleColumns = []string{
+	"id",
+	"name",
+	"description",
+	"permissions",
+	"built_in",
+	"created_at",
+	"updated_at",
+}
+
+func (p *roleRepo) roleSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(roleColumns...).
+		From(p.tableName)
+}
+
+func scanRole(row pgx.Row) (*entity.Role, error) {
+	var (
+		role      entity.Role
+		updatedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&role.Id,
+		&role.Name,
+		&role.Description,
+		&role.Permissions,
+		&role.BuiltIn,
+		&role.CreatedAt,
+		&updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if updatedAt.Valid {
+		role.UpdatedAt = updatedAt.Time
+	}
+
+	return &role, nil
+}
+
+func (p *roleRepo) Create(ctx context.Context, role *entity.Role) (err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"Create")
+	defer func() { span.EndError(err) }()
+
+	data := map[string]any{
+		"id":          role.Id,
+		"name":        role.Name,
+		"description": role.Description,
+		"permissions": role.Permissions,
+	}
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).Suffix("RETURNING created_at").ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&role.CreatedAt); err != nil {
+		if errors.Is(p.db.Error(err), entity.ErrorConflict) {
+			return entity.NewErrConflict("role with this name")
+		}
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+func (p *roleRepo) Get(ctx context.Context, id string) (_ *entity.Role, err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"Get")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.roleSelectQueryPrefix().Where(p.db.Sq.Equal("id", id)).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	role, err := scanRole(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("role")
+		}
+		return nil, p.db.Error(err)
+	}
+
+	return role, nil
+}
+
+func (p *roleRepo) List(ctx context.Context, limit, offset uint64) (_ []*entity.Role, err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"List")
+	defer func() { span.EndError(err) }()
+
+	queryBuilder := p.roleSelectQueryPrefix().OrderBy("name")
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	return p.queryRoles(ctx, query, args...)
+}
+
+func (p *roleRepo) queryRoles(ctx context.Context, query string, args ...any) ([]*entity.Role, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var roles []*entity.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, p.db.Error(err)
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+// Update replaces the name, description and permissions of a role that is not built in
+func (p *roleRepo) Update(ctx context.Context, role *entity.Role) (err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"Update")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.Update(p.tableName).
+		SetMap(map[string]any{
+			"name":        role.Name,
+			"description": role.Description,
+			"permissions": role.Permissions,
+			"updated_at":  squirrel.Expr("NOW()"),
+		}).
+		Where(p.db.Sq.Equal("id", role.Id)).
+		Where("built_in = FALSE").
+		Suffix("RETURNING updated_at").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "update"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&role.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NewErrNotFound("role")
+		}
+		if errors.Is(p.db.Error(err), entity.ErrorConflict) {
+			return entity.NewErrConflict("role with this name")
+		}
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// Delete removes a role that is not built in together with its assignments, the history is kept
+func (p *roleRepo) Delete(ctx context.Context, id string) (err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"Delete")
+	defer func() { span.EndError(err) }()
+
+	query := `DELETE FROM roles WHERE id = $1 AND built_in = FALSE`
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	commandTag, err := p.db.Exec(ctx, query, id)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("role")
+	}
+
+	return nil
+}
+
+// Assign gives a role to an admin and records it in the assignment history
+func (p *roleRepo) Assign(ctx context.Context, adminId, roleId, actorId string) (err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"Assign")
+	defer func() { span.EndError(err) }()
+
+	query := `
+		INSERT INTO admin_roles (admin_id, role_id, assigned_by)
+		SELECT a.id, r.id, $3
+		FROM admins a, roles r
+		WHERE a.id = $1 AND a.deleted_at IS NULL AND r.id = $2
+		RETURNING (SELECT name FROM roles WHERE id = $2)
+	`
+	span.SetAttributes(otlp.DBAttributes(adminRolesTableName, query)...)
+
+	return p.changeAssignment(ctx, entity.RoleAssign, adminId, roleId, actorId, query, adminId, roleId, actorId)
+}
+
+// Revoke takes a role from an admin and records it in the assignment history
+func (p *roleRepo) Revoke(ctx context.Context, adminId, roleId, actorId string) (err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"Revoke")
+	defer func() { span.EndError(err) }()
+
+	query := `
+		DELETE FROM admin_roles ar
+		USING roles r
+		WHERE ar.admin_id = $1 AND ar.role_id = $2 AND r.id = ar.role_id
+		RETURNING r.name
+	`
+	span.SetAttributes(otlp.DBAttributes(adminRolesTableName, query)...)
+
+	return p.changeAssignment(ctx, entity.RoleRevoke, adminId, roleId, actorId, query, adminId, roleId)
+}
+
+// changeAssignment runs the assign or revoke query and its history entry in one transaction
+func (p *roleRepo) changeAssignment(ctx context.Context, action, adminId, roleId, actorId, query string, args ...any) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var roleName string
+	if err = tx.QueryRow(ctx, query, args...).Scan(&roleName); err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows) && action == entity.RoleAssign:
+			return entity.NewErrNotFound("admin or role")
+		case errors.Is(err, pgx.ErrNoRows):
+			return entity.NewErrNotFound("role assignment")
+		case errors.Is(p.db.Error(err), entity.ErrorConflict):
+			return entity.NewErrConflict("role assignment")
+		}
+		return p.db.Error(err)
+	}
+
+	if _, err = tx.Exec(ctx, `
+		INSERT INTO admin_role_history (admin_id, role_id, role_name, action, actor_id)
+		VALUES ($1, $2, $3, $4, $5)
+	`, adminId, roleId, roleName, action, actorId); err != nil {
+		return p.db.Error(err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListAdminRoles returns the roles assigned to an admin, without the built-in role of admins.role
+func (p *roleRepo) ListAdminRoles(ctx context.Context, adminId string) (_ []*entity.Role, err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"ListAdminRoles")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.roleSelectQueryPrefix().
+		Where("id IN (SELECT role_id FROM admin_roles WHERE admin_id = ?)", adminId).
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list admin roles"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	return p.queryRoles(ctx, query, args...)
+}
+
+// ListAssignmentHistory returns the newest entries first, filter keys are admin_id, role_id and actor_id
+func (p *roleRepo) ListAssignmentHistory(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.RoleAssignment, err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"ListAssignmentHistory")
+	defer func() { span.EndError(err) }()
+
+	queryBuilder := p.db.Sq.Builder.
+		Select("id", "admin_id", "role_id", "role_name", "action", "actor_id", "created_at").
+		From(roleHistoryTableName).
+		OrderBy("created_at DESC", "id DESC")
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+	for key, value := range filter {
+		switch key {
+		case "admin_id", "role_id", "actor_id":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", roleHistoryTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(roleHistoryTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var history []*entity.RoleAssignment
+	for rows.Next() {
+		var assignment entity.RoleAssignment
+		if err = rows.Scan(
+			&assignment.Id,
+			&assignment.AdminId,
+			&assignment.RoleId,
+			&assignment.RoleName,
+			&assignment.Action,
+			&assignment.ActorId,
+			&assignment.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		history = append(history, &assignment)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(history))))
+
+	return history, rows.Err()
+}
+
+// AdminPermissions resolves the permissions of an active admin from the built-in role named by
+// admins.role and the assigned roles, an unknown, pending, terminated or deleted admin has none
+func (p *roleRepo) AdminPermissions(ctx context.Context, adminId string) (_ []string, err error) {
+	ctx, span := otlp.Start(ctx, roleServiceName, roleSpanRepoPrefix+"AdminPermissions")
+	defer func() { span.EndError(err) }()
+
+	query := `
+		SELECT DISTINCT permission
+		FROM admins a
+		JOIN roles r ON r.name = a.role::text
+			OR r.id IN (SELECT role_id FROM admin_roles WHERE admin_id = a.id)
+		CROSS JOIN LATERAL unnest(r.permissions) AS permission
+		WHERE a.id = $1
+		AND a.deleted_at IS NULL
+		AND a.activated_at IS NOT NULL
+		AND a.terminated_at IS NULL
+	`
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, adminId)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var permissions []string
+	for rows.Next() {
+		var permission string
+		if err = rows.Scan(&permission); err != nil {
+			return nil, p.db.Error(err)
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
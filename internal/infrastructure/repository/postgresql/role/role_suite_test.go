@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	adminRepo "dennic_user_service/internal/infrastructure/repository/postgresql/admin"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoleRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *RoleRepositoryTestSuite) TestRoles() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	roleRepo := NewRoleRepo(s.DB)
+	admins := adminRepo.NewAdminRepo(s.DB)
+	ctx := context.Background()
+
+	admin := entity.Admin{
+		Id:            uuid.New().String(),
+		Role:          "admin",
+		FirstName:     "roledata",
+		LastName:      "roledata",
+		BirthDate:     "2000-08-30",
+		PhoneNumber:   uuid.New().String(),
+		Email:         "roledata",
+		Password:      "roledata",
+		Gender:        "male",
+		StartWorkYear: "2000-08-30",
+		CreatedAt:     time.Now().UTC(),
+	}
+	s.Require().NoError(admins.Create(ctx, &admin))
+
+	role := entity.Role{
+		Id:          uuid.New().String(),
+		Name:        "auditor " + uuid.New().String(),
+		Description: "reads the audit log",
+		Permissions: []string{entity.PermissionAuditRead},
+	}
+
+	// check create role method
+	err = roleRepo.Create(ctx, &role)
+	s.Suite.NoError(err)
+	var errConflict *entity.ErrConflict
+	s.Suite.ErrorAs(roleRepo.Create(ctx, &entity.Role{Id: uuid.New().String(), Name: role.Name}), &errConflict)
+
+	// check get role method
+	got, err := roleRepo.Get(ctx, role.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(got.Permissions, role.Permissions)
+	s.Suite.False(got.BuiltIn)
+
+	// the built-in role of admins.role is granted without an assignment
+	permissions, err := roleRepo.AdminPermissions(ctx, admin.Id)
+	s.Suite.NoError(err)
+	s.Suite.Contains(permissions, entity.PermissionUsersRead)
+	s.Suite.NotContains(permissions, entity.PermissionAuditRead)
+
+	// check assign role method
+	err = roleRepo.Assign(ctx, admin.Id, role.Id, "actor")
+	s.Suite.NoError(err)
+	s.Suite.ErrorAs(roleRepo.Assign(ctx, admin.Id, role.Id, "actor"), &errConflict)
+	permissions, err = roleRepo.AdminPermissions(ctx, admin.Id)
+	s.Suite.NoError(err)
+	s.Suite.Contains(permissions, entity.PermissionAuditRead)
+
+	assigned, err := roleRepo.ListAdminRoles(ctx, admin.Id)
+	s.Suite.NoError(err)
+	s.Suite.Len(assigned, 1)
+
+	// check update role method
+	role.Permissions = []string{entity.PermissionAuditRead, entity.PermissionRolesRead}
+	err = roleRepo.Update(ctx, &role)
+	s.Suite.NoError(err)
+	s.Suite.False(role.UpdatedAt.IsZero())
+
+	// check revoke role method
+	err = roleRepo.Revoke(ctx, admin.Id, role.Id, "actor")
+	s.Suite.NoError(err)
+	var errNotFound *entity.ErrNotFound
+	s.Suite.ErrorAs(roleRepo.Revoke(ctx, admin.Id, role.Id, "actor"), &errNotFound)
+
+	history, err := roleRepo.ListAssignmentHistory(ctx, 10, 0, map[string]string{"admin_id": admin.Id})
+	s.Suite.NoError(err)
+	s.Suite.Len(history, 2)
+	s.Suite.Equal(history[0].Action, entity.RoleRevoke)
+	s.Suite.Equal(history[1].RoleName, role.Name)
+
+	// check delete role method, built-in roles can not be deleted
+	err = roleRepo.Delete(ctx, role.Id)
+	s.Suite.NoError(err)
+	_, err = roleRepo.Get(ctx, role.Id)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	roles, err := roleRepo.List(ctx, 0, 0)
+	s.Suite.NoError(err)
+	for _, builtIn := range roles {
+		if builtIn.BuiltIn {
+			s.Suite.ErrorAs(roleRepo.Delete(ctx, builtIn.Id), &errNotFound)
+		}
+	}
+
+	s.Suite.NoError(admins.Delete(ctx, admin.Id))
+}
+
+func TestRoleRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(RoleRepositoryTestSuite))
+}
@@ -0,0 +1,87 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"fmt"
+)
+
+// GetMany returns the live users among ids with a single query, in no particular order,
+// ids must be valid uuids and the ids of missing users are left out
+func (p *userRepo) GetMany(ctx context.Context, ids []string) (_ []*entity.User, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetMany")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.userSelectQueryPrefix().
+		Where("id = ANY(?::uuid[])", ids).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get many"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		var (
+			user            entity.User
+			birthDate       sql.NullString
+			updatedAt       sql.NullTime
+			statusExpiresAt sql.NullTime
+			email           sql.NullString
+			emailVerifiedAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&user.Id,
+			&user.UserOrder,
+			&user.FirstName,
+			&user.LastName,
+			&birthDate,
+			&user.PhoneNumber,
+			&user.Password,
+			&user.Gender,
+			&user.Version,
+			&user.CreatedAt,
+			&updatedAt,
+			&user.Status,
+			&user.StatusReason,
+			&statusExpiresAt,
+			&email,
+			&emailVerifiedAt,
+			&user.PreferredLanguage,
+			&user.AddressLine,
+			&user.City,
+			&user.Country,
+			&user.AvatarKey,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		if birthDate.Valid {
+			user.BirthDate = birthDate.String
+		}
+		if updatedAt.Valid {
+			user.UpdatedAt = updatedAt.Time
+		}
+		if statusExpiresAt.Valid {
+			user.StatusExpiresAt = statusExpiresAt.Time
+		}
+		if email.Valid {
+			user.Email = email.String
+		}
+		if emailVerifiedAt.Valid {
+			user.EmailVerifiedAt = emailVerifiedAt.Time
+		}
+		users = append(users, &user)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(users))))
+
+	return users, rows.Err()
+}
@@ -0,0 +1,69 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserBatchRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *UserBatchRepositoryTestSuite) TestGetMany() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	var users []*entity.User
+	for i := 0; i < 3; i++ {
+		users = append(users, &entity.User{
+			Id:          uuid.New().String(),
+			FirstName:   "batchdata",
+			LastName:    "batchdata",
+			BirthDate:   "2000-08-30",
+			PhoneNumber: uuid.New().String(),
+			Gender:      "male",
+			Status:      entity.UserStatusActive,
+			CreatedAt:   time.Now().UTC(),
+		})
+	}
+	_, err = userRepo.CopyUsers(ctx, users)
+	s.Require().NoError(err)
+
+	// check that deleted and unknown users are left out
+	s.Require().NoError(userRepo.Delete(ctx, users[2].Id))
+	found, err := userRepo.GetMany(ctx, []string{users[0].Id, users[1].Id, users[2].Id, uuid.New().String()})
+	s.Suite.NoError(err)
+	s.Require().Len(found, 2)
+	for _, user := range found {
+		s.Suite.Contains([]string{users[0].Id, users[1].Id}, user.Id)
+		s.Suite.Equal("2000-08-30", user.BirthDate)
+	}
+
+	for _, user := range users[:2] {
+		s.Suite.NoError(userRepo.Delete(ctx, user.Id))
+	}
+}
+
+func TestUserBatchRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserBatchRepositoryTestSuite))
+}
@@ -0,0 +1,210 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	consentDocumentTableName = "consent_documents"
+	consentTableName         = "user_consents"
+)
+
+var consentColumns = []string{"id", "user_id", "document_type", "document_version", "ip", "accepted_at", "withdrawn_at"}
+
+// PublishConsentDocument adds the next version of document.Type and sets document.Version
+func (p *userRepo) PublishConsentDocument(ctx context.Context, document *entity.ConsentDocument) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"PublishConsentDocument")
+	defer func() { span.EndError(err) }()
+
+	// two concurrent publications get the same version, the primary key lets only one of them in
+	sqlStr := fmt.Sprintf(`
+		INSERT INTO %[1]s (type, version, url, content_hash, published_by)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, $4 FROM %[1]s WHERE type = $1
+		RETURNING version, published_at
+	`, consentDocumentTableName)
+	span.SetAttributes(otlp.DBAttributes(consentDocumentTableName, sqlStr)...)
+
+	if err = p.db.QueryRow(ctx, sqlStr, document.Type, document.Url, document.ContentHash, document.PublishedBy).
+		Scan(&document.Version, &document.PublishedAt); err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// GetConsentDocument returns a version of a document, the current one when version is 0
+func (p *userRepo) GetConsentDocument(ctx context.Context, documentType string, version uint64) (_ *entity.ConsentDocument, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetConsentDocument")
+	defer func() { span.EndError(err) }()
+
+	builder := p.db.Sq.Builder.
+		Select("type", "version", "url", "content_hash", "published_by", "published_at").
+		From(consentDocumentTableName).
+		Where(p.db.Sq.Equal("type", documentType))
+	if version != 0 {
+		builder = builder.Where(p.db.Sq.Equal("version", version))
+	}
+	query, args, err := builder.OrderBy("version DESC").Limit(1).ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", consentDocumentTableName, "get"))
+	}
+	span.SetAttributes(otlp.DBAttributes(consentDocumentTableName, query)...)
+
+	var document entity.ConsentDocument
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&document.Type,
+		&document.Version,
+		&document.Url,
+		&document.ContentHash,
+		&document.PublishedBy,
+		&document.PublishedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("consent document")
+		}
+		return nil, p.db.Error(err)
+	}
+
+	return &document, nil
+}
+
+// consentInsert builds the insert of a consent, for a query or a transaction. subject_id keeps the
+// user who gave it after the consent moves to a merged account or its user is purged
+func (p *userRepo) consentInsert(consent *entity.Consent) (string, []any, error) {
+	query, args, err := p.db.Sq.Builder.Insert(consentTableName).
+		SetMap(map[string]any{
+			"id":               consent.Id,
+			"user_id":          consent.UserId,
+			"subject_id":       consent.UserId,
+			"document_type":    consent.DocumentType,
+			"document_version": consent.DocumentVersion,
+			"ip":               consent.IP,
+		}).
+		Suffix("RETURNING accepted_at").
+		ToSql()
+	if err != nil {
+		return "", nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", consentTableName, "create"))
+	}
+	return query, args, nil
+}
+
+func (p *userRepo) CreateConsent(ctx context.Context, consent *entity.Consent) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CreateConsent")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.consentInsert(consent)
+	if err != nil {
+		return err
+	}
+	span.SetAttributes(otlp.DBAttributes(consentTableName, query)...)
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&consent.AcceptedAt); err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// ListConsents returns every consent a user gave, withdrawn ones included, the newest first
+func (p *userRepo) ListConsents(ctx context.Context, userId string) (_ []*entity.Consent, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ListConsents")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(consentColumns...).
+		From(consentTableName).
+		Where(p.db.Sq.Equal("user_id", userId)).
+		OrderBy("accepted_at DESC", "id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", consentTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(consentTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	consents, err := scanConsents(rows)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(consents))))
+
+	return consents, nil
+}
+
+// WithdrawConsent withdraws every standing consent of a user to a document type and returns them,
+// ErrNotFound when there is none
+func (p *userRepo) WithdrawConsent(ctx context.Context, userId, documentType string) (_ []*entity.Consent, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"WithdrawConsent")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.Update(consentTableName).
+		Set("withdrawn_at", squirrel.Expr("NOW()")).
+		Where(p.db.Sq.Equal("user_id", userId)).
+		Where(p.db.Sq.Equal("document_type", documentType)).
+		Where("withdrawn_at IS NULL").
+		Suffix("RETURNING " + strings.Join(consentColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", consentTableName, "withdraw"))
+	}
+	span.SetAttributes(otlp.DBAttributes(consentTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	consents, err := scanConsents(rows)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	if len(consents) == 0 {
+		return nil, entity.NewErrNotFound("consent")
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(consents))))
+
+	return consents, nil
+}
+
+func scanConsents(rows pgx.Rows) ([]*entity.Consent, error) {
+	var consents []*entity.Consent
+	for rows.Next() {
+		var (
+			consent     entity.Consent
+			withdrawnAt sql.NullTime
+		)
+		if err := rows.Scan(
+			&consent.Id,
+			&consent.UserId,
+			&consent.DocumentType,
+			&consent.DocumentVersion,
+			&consent.IP,
+			&consent.AcceptedAt,
+			&withdrawnAt,
+		); err != nil {
+			return nil, err
+		}
+		if withdrawnAt.Valid {
+			consent.WithdrawnAt = withdrawnAt.Time
+		}
+		consents = append(consents, &consent)
+	}
+	return consents, rows.Err()
+}
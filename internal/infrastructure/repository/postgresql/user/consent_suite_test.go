@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConsentRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *ConsentRepositoryTestSuite) TestConsents() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	// check the current document
+	terms, err := userRepo.GetConsentDocument(ctx, entity.ConsentTypeTerms, 0)
+	s.Require().NoError(err)
+	s.Suite.NotZero(terms.Version)
+	var errNotFound *entity.ErrNotFound
+	_, err = userRepo.GetConsentDocument(ctx, entity.ConsentTypeTerms, terms.Version+1000)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	// check that create stores the consents given at signup
+	userId := uuid.New().String()
+	user := entity.User{
+		Id:          userId,
+		FirstName:   "consentdata",
+		LastName:    "consentdata",
+		BirthDate:   "2000-08-30",
+		PhoneNumber: uuid.New().String(),
+		Password:    "consentdata",
+		Gender:      "male",
+		CreatedAt:   time.Now().UTC(),
+		Consents: []*entity.Consent{{
+			Id:              uuid.New().String(),
+			UserId:          userId,
+			DocumentType:    entity.ConsentTypeTerms,
+			DocumentVersion: terms.Version,
+			IP:              "10.0.0.1",
+		}},
+	}
+	s.Require().NoError(userRepo.Create(ctx, &user))
+	s.Suite.False(user.Consents[0].AcceptedAt.IsZero())
+
+	// a consent to a version that was never published is rejected
+	s.Suite.Error(userRepo.CreateConsent(ctx, &entity.Consent{
+		Id:              uuid.New().String(),
+		UserId:          userId,
+		DocumentType:    entity.ConsentTypeDataSharing,
+		DocumentVersion: terms.Version + 1000,
+	}))
+
+	// check record, list and withdraw consent methods
+	dataSharing, err := userRepo.GetConsentDocument(ctx, entity.ConsentTypeDataSharing, 0)
+	s.Require().NoError(err)
+	s.Suite.NoError(userRepo.CreateConsent(ctx, &entity.Consent{
+		Id:              uuid.New().String(),
+		UserId:          userId,
+		DocumentType:    entity.ConsentTypeDataSharing,
+		DocumentVersion: dataSharing.Version,
+	}))
+	consents, err := userRepo.ListConsents(ctx, userId)
+	s.Suite.NoError(err)
+	s.Suite.Len(consents, 2)
+
+	withdrawn, err := userRepo.WithdrawConsent(ctx, userId, entity.ConsentTypeDataSharing)
+	s.Suite.NoError(err)
+	s.Require().Len(withdrawn, 1)
+	s.Suite.False(withdrawn[0].WithdrawnAt.IsZero())
+	_, err = userRepo.WithdrawConsent(ctx, userId, entity.ConsentTypeDataSharing)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	// check that erasing keeps the consents without their address
+	_, err = userRepo.Erase(ctx, userId)
+	s.Suite.NoError(err)
+	consents, err = userRepo.ListConsents(ctx, userId)
+	s.Suite.NoError(err)
+	s.Suite.Len(consents, 2)
+	for _, consent := range consents {
+		s.Suite.Empty(consent.IP)
+	}
+}
+
+func TestConsentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentRepositoryTestSuite))
+}
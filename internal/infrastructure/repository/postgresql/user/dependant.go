@@ -0,0 +1,214 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+const dependantTableName = "user_dependants"
+
+// dependantSelectQueryPrefix reads live dependants with their guardianship,
+// the columns of the link are renamed so they do not clash with userColumns
+func (p *userRepo) dependantSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(append(userColumns, "guardian_id", "relationship", "linked_at", "link_updated_at")...).
+		From(p.tableName).
+		Join(fmt.Sprintf(`(SELECT dependant_id, guardian_id, relationship, created_at AS linked_at, updated_at AS link_updated_at FROM %s) d
+			ON d.dependant_id = %s.id`, dependantTableName, p.tableName)).
+		Where("deleted_at IS NULL")
+}
+
+// CreateDependant inserts the profile of a dependant and links it to its guardian, the guardian
+// must be a live user who is not a dependant itself
+func (p *userRepo) CreateDependant(ctx context.Context, dependant *entity.Dependant) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CreateDependant")
+	defer func() { span.EndError(err) }()
+
+	userQuery, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(userInsertData(dependant.User)).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create dependant"))
+	}
+	linkQuery := fmt.Sprintf(`
+		INSERT INTO %[1]s (dependant_id, guardian_id, relationship)
+		SELECT $1::uuid, id, $3 FROM %[2]s
+		WHERE id = $2
+		AND deleted_at IS NULL
+		AND id NOT IN (SELECT dependant_id FROM %[1]s)
+		RETURNING created_at
+	`, dependantTableName, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(dependantTableName, linkQuery)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, userQuery, args...); err != nil {
+		return p.db.Error(err)
+	}
+	if err = tx.QueryRow(ctx, linkQuery, dependant.User.Id, dependant.GuardianId, dependant.Relationship).
+		Scan(&dependant.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NewErrNotFound("guardian")
+		}
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return tx.Commit(ctx)
+}
+
+// ListDependants returns the live dependants of a guardian, the oldest link first
+func (p *userRepo) ListDependants(ctx context.Context, guardianId string) (_ []*entity.Dependant, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ListDependants")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.dependantSelectQueryPrefix().
+		Where(p.db.Sq.Equal("guardian_id", guardianId)).
+		OrderBy("linked_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", dependantTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(dependantTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var dependants []*entity.Dependant
+	for rows.Next() {
+		dependant, err := scanDependant(rows)
+		if err != nil {
+			return nil, p.db.Error(err)
+		}
+		dependants = append(dependants, dependant)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(dependants))))
+
+	return dependants, rows.Err()
+}
+
+// GetDependant returns a live dependant with its guardianship, ErrNotFound when the user is no dependant
+func (p *userRepo) GetDependant(ctx context.Context, dependantId string) (_ *entity.Dependant, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetDependant")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.dependantSelectQueryPrefix().
+		Where(p.db.Sq.Equal("id", dependantId)).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", dependantTableName, "get"))
+	}
+	span.SetAttributes(otlp.DBAttributes(dependantTableName, query)...)
+
+	dependant, err := scanDependant(p.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.NewErrNotFound("dependant")
+		}
+		return nil, p.db.Error(err)
+	}
+
+	return dependant, nil
+}
+
+// TransferDependant moves a dependant to another guardian, who must be a live user and no dependant
+func (p *userRepo) TransferDependant(ctx context.Context, dependant *entity.Dependant) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"TransferDependant")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET guardian_id = $1, relationship = $2, updated_at = NOW()
+		WHERE dependant_id = $3
+		AND EXISTS (SELECT 1 FROM %[2]s WHERE id = $1 AND deleted_at IS NULL)
+		AND $1::uuid NOT IN (SELECT dependant_id FROM %[1]s)
+		RETURNING created_at, updated_at
+	`, dependantTableName, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(dependantTableName, sqlStr)...)
+
+	if err = p.db.QueryRow(ctx, sqlStr, dependant.GuardianId, dependant.Relationship, dependant.User.Id).
+		Scan(&dependant.CreatedAt, &dependant.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NewErrNotFound("dependant or guardian")
+		}
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+func scanDependant(row pgx.Row) (*entity.Dependant, error) {
+	var (
+		user            entity.User
+		dependant       = entity.Dependant{User: &user}
+		birthDate       sql.NullTime
+		updatedAt       sql.NullTime
+		statusExpiresAt sql.NullTime
+		email           sql.NullString
+		emailVerifiedAt sql.NullTime
+		linkUpdatedAt   sql.NullTime
+	)
+	if err := row.Scan(
+		&user.Id,
+		&user.UserOrder,
+		&user.FirstName,
+		&user.LastName,
+		&birthDate,
+		&user.PhoneNumber,
+		&user.Password,
+		&user.Gender,
+		&user.Version,
+		&user.CreatedAt,
+		&updatedAt,
+		&user.Status,
+		&user.StatusReason,
+		&statusExpiresAt,
+		&email,
+		&emailVerifiedAt,
+		&user.PreferredLanguage,
+		&user.AddressLine,
+		&user.City,
+		&user.Country,
+		&user.AvatarKey,
+		&dependant.GuardianId,
+		&dependant.Relationship,
+		&dependant.CreatedAt,
+		&linkUpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if birthDate.Valid {
+		user.BirthDate = birthDate.Time.Format("2006-01-02")
+	}
+	if updatedAt.Valid {
+		user.UpdatedAt = updatedAt.Time
+	}
+	if statusExpiresAt.Valid {
+		user.StatusExpiresAt = statusExpiresAt.Time
+	}
+	if email.Valid {
+		user.Email = email.String
+	}
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = emailVerifiedAt.Time
+	}
+	if linkUpdatedAt.Valid {
+		dependant.UpdatedAt = linkUpdatedAt.Time
+	}
+
+	return &dependant, nil
+}
@@ -0,0 +1,144 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type DependantRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *DependantRepositoryTestSuite) TestDependants() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	var guardians []entity.User
+	for i := 0; i < 2; i++ {
+		guardian := entity.User{
+			Id:          uuid.New().String(),
+			FirstName:   "guardiandata",
+			LastName:    "guardiandata",
+			BirthDate:   "1980-08-30",
+			PhoneNumber: uuid.New().String(),
+			Password:    "guardiandata",
+			Gender:      "female",
+			CreatedAt:   time.Now().UTC(),
+		}
+		s.Require().NoError(userRepo.Create(ctx, &guardian))
+		guardians = append(guardians, guardian)
+	}
+
+	// check create dependant method, two dependants share the empty phone number
+	var dependants []entity.Dependant
+	for i := 0; i < 2; i++ {
+		dependant := entity.Dependant{
+			GuardianId:   guardians[0].Id,
+			Relationship: entity.DependantRelationshipChild,
+			User: &entity.User{
+				Id:        uuid.New().String(),
+				FirstName: "dependantdata",
+				LastName:  "dependantdata",
+				BirthDate: "2018-08-30",
+				Gender:    "male",
+				CreatedAt: time.Now().UTC(),
+			},
+		}
+		s.Require().NoError(userRepo.CreateDependant(ctx, &dependant))
+		dependants = append(dependants, dependant)
+	}
+
+	// check that a dependant can not become a guardian
+	var errNotFound *entity.ErrNotFound
+	nested := entity.Dependant{
+		GuardianId:   dependants[0].User.Id,
+		Relationship: entity.DependantRelationshipChild,
+		User:         &entity.User{Id: uuid.New().String(), FirstName: "nested", LastName: "nested", BirthDate: "2020-01-01", Gender: "male"},
+	}
+	s.Suite.ErrorAs(userRepo.CreateDependant(ctx, &nested), &errNotFound)
+
+	// check list and get dependant methods
+	listed, err := userRepo.ListDependants(ctx, guardians[0].Id)
+	s.Suite.NoError(err)
+	s.Suite.Len(listed, 2)
+	got, err := userRepo.GetDependant(ctx, dependants[0].User.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(got.GuardianId, guardians[0].Id)
+	s.Suite.Equal(got.User.BirthDate, "2018-08-30")
+	_, err = userRepo.GetDependant(ctx, guardians[0].Id)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	// check that a dependant can not get a session
+	refreshed, err := userRepo.UpdateRefreshToken(ctx, &entity.UpdateRefreshTokenReq{Id: got.User.Id, RefreshToken: "token"})
+	s.Suite.NoError(err)
+	s.Suite.False(refreshed.Status)
+
+	// check transfer dependant method
+	got.GuardianId = guardians[1].Id
+	got.Relationship = entity.DependantRelationshipSibling
+	s.Suite.NoError(userRepo.TransferDependant(ctx, got))
+	s.Suite.False(got.UpdatedAt.IsZero())
+	listed, err = userRepo.ListDependants(ctx, guardians[1].Id)
+	s.Suite.NoError(err)
+	s.Suite.Len(listed, 1)
+	got.GuardianId = dependants[1].User.Id
+	s.Suite.ErrorAs(userRepo.TransferDependant(ctx, got), &errNotFound)
+
+	// check that purge keeps guardians as long as they have dependants, users deleted long ago
+	// are the only ones old enough for it
+	const retention = 5 * 365 * 24 * time.Hour
+	backdate := func(ids ...string) {
+		_, err := s.DB.Exec(ctx, `UPDATE users SET deleted_at = NOW() - interval '10 years' WHERE id = ANY($1)`, ids)
+		s.Require().NoError(err)
+	}
+	for _, guardian := range guardians {
+		s.Suite.NoError(userRepo.Delete(ctx, guardian.Id))
+	}
+	backdate(guardians[0].Id, guardians[1].Id)
+	_, err = userRepo.Purge(ctx, retention)
+	s.Require().NoError(err)
+	for _, guardian := range guardians {
+		_, err = userRepo.GetPersonalData(ctx, guardian.Id)
+		s.Suite.NoError(err)
+	}
+	_, err = userRepo.GetDependant(ctx, dependants[0].User.Id)
+	s.Suite.NoError(err)
+
+	// once the dependants are purged their guardians follow
+	for _, dependant := range dependants {
+		s.Suite.NoError(userRepo.Delete(ctx, dependant.User.Id))
+	}
+	backdate(dependants[0].User.Id, dependants[1].User.Id)
+	for i := 0; i < 2; i++ {
+		_, err = userRepo.Purge(ctx, retention)
+		s.Require().NoError(err)
+	}
+	for _, guardian := range guardians {
+		_, err = userRepo.GetPersonalData(ctx, guardian.Id)
+		s.Suite.ErrorAs(err, &errNotFound)
+	}
+}
+
+func TestDependantRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DependantRepositoryTestSuite))
+}
@@ -0,0 +1,68 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/pkg/otlp"
+	"fmt"
+)
+
+// exportBatch is how many rows an export fetches from its cursor at a time
+const exportBatch = 1000
+
+// userExportColumns are the expressions of the exportable columns, passwords and tokens have none
+var userExportColumns = map[string]string{
+	"id":                 "id::text",
+	"user_order":         "user_order",
+	"first_name":         "first_name",
+	"last_name":          "last_name",
+	"birth_date":         "to_char(birth_date, 'YYYY-MM-DD')",
+	"phone_number":       "phone_number",
+	"gender":             "gender::text",
+	"status":             userStatus,
+	"status_reason":      "CASE WHEN " + userStatusLapsed + " THEN '' ELSE status_reason END",
+	"status_expires_at":  "CASE WHEN " + userStatusLapsed + " THEN NULL ELSE status_expires_at END",
+	"email":              "email",
+	"email_verified_at":  "email_verified_at",
+	"preferred_language": "preferred_language",
+	"address_line":       "address_line",
+	"city":               "city",
+	"country":            "country",
+	"created_at":         "created_at",
+	"updated_at":         "updated_at",
+}
+
+// Export calls fn with the values of columns of every live user matching the filters of listing,
+// in user order, the users are read through a cursor
+func (p *userRepo) Export(ctx context.Context, columns []string, filter map[string]string, fn func([]any) error) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Export")
+	defer func() { span.EndError(err) }()
+
+	expressions := make([]string, len(columns))
+	for i, column := range columns {
+		expression, ok := userExportColumns[column]
+		if !ok {
+			return fmt.Errorf("column %s of %s can not be exported", column, p.tableName)
+		}
+		expressions[i] = expression + " AS " + column
+	}
+
+	queryBuilder := p.db.Sq.Builder.
+		Select(expressions...).
+		From(p.tableName).
+		Where("deleted_at IS NULL").
+		OrderBy("user_order")
+	query, args, err := p.userFilter(queryBuilder, filter).ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "export"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var exported int64
+	err = p.db.Cursor(ctx, "user_export", exportBatch, query, args, func(values []any) error {
+		exported++
+		return fn(values)
+	})
+	span.SetAttributes(otlp.RowsAffected(exported))
+
+	return err
+}
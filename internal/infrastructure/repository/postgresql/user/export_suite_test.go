@@ -0,0 +1,78 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserExportRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *UserExportRepositoryTestSuite) TestExport() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	city := uuid.New().String()
+	var users []*entity.User
+	for i := 0; i < exportBatch+1; i++ {
+		users = append(users, &entity.User{
+			Id:          uuid.New().String(),
+			FirstName:   "exportdata",
+			LastName:    "exportdata",
+			BirthDate:   "2000-08-30",
+			PhoneNumber: uuid.New().String(),
+			Gender:      "male",
+			Status:      entity.UserStatusActive,
+			City:        city,
+			CreatedAt:   time.Now().UTC(),
+		})
+	}
+	_, err = userRepo.CopyUsers(ctx, users)
+	s.Require().NoError(err)
+
+	// check that the cursor reads past its first batch and keeps the filters of listing
+	var rows [][]any
+	err = userRepo.Export(ctx, []string{"id", "birth_date", "gender", "email", "created_at"}, map[string]string{"city": city}, func(values []any) error {
+		rows = append(rows, values)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(rows, len(users))
+	s.Suite.Equal(users[0].Id, rows[0][0])
+	s.Suite.Equal("2000-08-30", rows[0][1])
+	s.Suite.Equal("male", rows[0][2])
+	s.Suite.Nil(rows[0][3])
+	s.Suite.IsType(time.Time{}, rows[0][4])
+
+	// passwords are not exportable
+	s.Suite.Error(userRepo.Export(ctx, []string{"id", "password"}, nil, func([]any) error { return nil }))
+
+	for _, user := range users {
+		s.Suite.NoError(userRepo.Delete(ctx, user.Id))
+	}
+}
+
+func TestUserExportRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserExportRepositoryTestSuite))
+}
@@ -0,0 +1,100 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"fmt"
+	"sort"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+)
+
+// FindByContacts returns the live users holding one of the phone numbers or emails,
+// with their id, phone number and email only
+func (p *userRepo) FindByContacts(ctx context.Context, phoneNumbers, emails []string) (_ []*entity.User, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"FindByContacts")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("id", "phone_number", "email").
+		From(p.tableName).
+		Where("deleted_at IS NULL").
+		Where(squirrel.Or{
+			squirrel.Expr("phone_number = ANY(?)", phoneNumbers),
+			squirrel.Expr("lower(email) = ANY(?)", emails),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "find by contacts"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var users []*entity.User
+	for rows.Next() {
+		var (
+			user  entity.User
+			email sql.NullString
+		)
+		if err = rows.Scan(&user.Id, &user.PhoneNumber, &email); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if email.Valid {
+			user.Email = email.String
+		}
+		users = append(users, &user)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(users))))
+
+	return users, rows.Err()
+}
+
+// CopyUsers inserts users in bulk with the copy protocol, in a single transaction
+func (p *userRepo) CopyUsers(ctx context.Context, users []*entity.User) (_ int64, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CopyUsers")
+	defer func() { span.EndError(err) }()
+
+	if len(users) == 0 {
+		return 0, nil
+	}
+
+	// the users of an import share their columns, status included
+	var columns []string
+	for column := range userInsertData(users[0]) {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, "COPY "+p.tableName)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	copied, err := tx.CopyFrom(ctx, pgx.Identifier{p.tableName}, columns, pgx.CopyFromSlice(len(users), func(i int) ([]any, error) {
+		data := userInsertData(users[i])
+		if len(data) != len(columns) {
+			return nil, fmt.Errorf("user %s has %d columns, expected %d", users[i].Id, len(data), len(columns))
+		}
+		row := make([]any, len(columns))
+		for j, column := range columns {
+			row[j] = data[column]
+		}
+		return row, nil
+	}))
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(copied))
+
+	return copied, tx.Commit(ctx)
+}
@@ -0,0 +1,84 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserImportRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *UserImportRepositoryTestSuite) TestCopyUsers() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	var users []*entity.User
+	for i := 0; i < 3; i++ {
+		users = append(users, &entity.User{
+			Id:          uuid.New().String(),
+			FirstName:   "importdata",
+			LastName:    "importdata",
+			BirthDate:   "2000-08-30",
+			PhoneNumber: uuid.New().String(),
+			Gender:      "female",
+			Status:      entity.UserStatusActive,
+			CreatedAt:   time.Now().UTC(),
+		})
+	}
+	users[0].Email = uuid.New().String() + "@dennic.uz"
+
+	// check copy users method
+	copied, err := userRepo.CopyUsers(ctx, users)
+	s.Require().NoError(err)
+	s.Suite.Equal(int64(len(users)), copied)
+	imported, err := userRepo.Get(ctx, map[string]string{"id": users[1].Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal("2000-08-30", imported.BirthDate)
+
+	// check find by contacts method
+	found, err := userRepo.FindByContacts(ctx, []string{users[1].PhoneNumber, uuid.New().String()}, []string{users[0].Email})
+	s.Suite.NoError(err)
+	s.Suite.Len(found, 2)
+
+	// a taken phone number fails the whole copy
+	_, err = userRepo.CopyUsers(ctx, []*entity.User{users[2], {
+		Id:          uuid.New().String(),
+		FirstName:   "importdata",
+		LastName:    "importdata",
+		BirthDate:   "2000-08-30",
+		PhoneNumber: uuid.New().String(),
+		Gender:      "female",
+		Status:      entity.UserStatusActive,
+		CreatedAt:   time.Now().UTC(),
+	}})
+	s.Suite.Error(err)
+
+	for _, user := range users {
+		s.Suite.NoError(userRepo.Delete(ctx, user.Id))
+	}
+}
+
+func TestUserImportRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserImportRepositoryTestSuite))
+}
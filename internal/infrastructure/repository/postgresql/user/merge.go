@@ -0,0 +1,258 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	duplicateTableName = "user_duplicates"
+	duplicateBatch     = 1000
+)
+
+// DuplicatePairs calls fn with every pair of live users sharing a birth date and the first letter
+// of a name, in either order as names get swapped. The user of a pair sorts before the other by id
+// and only the id, names and birth date of both are read
+func (p *userRepo) DuplicatePairs(ctx context.Context, fn func(user, other *entity.User) error) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"DuplicatePairs")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		SELECT a.id::text, a.first_name, a.last_name, to_char(a.birth_date, 'YYYY-MM-DD'),
+			b.id::text, b.first_name, b.last_name, to_char(b.birth_date, 'YYYY-MM-DD')
+		FROM %[1]s a
+		JOIN %[1]s b ON b.birth_date = a.birth_date AND a.id < b.id
+		WHERE a.deleted_at IS NULL
+		AND b.deleted_at IS NULL
+		AND (lower(left(a.first_name, 1)) = lower(left(b.first_name, 1))
+			OR lower(left(a.last_name, 1)) = lower(left(b.last_name, 1))
+			OR lower(left(a.first_name, 1)) = lower(left(b.last_name, 1)))
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	var pairs int64
+	err = p.db.Cursor(ctx, "user_duplicate_pairs", duplicateBatch, sqlStr, nil, func(values []interface{}) error {
+		pairs++
+		user := &entity.User{Id: values[0].(string), FirstName: values[1].(string), LastName: values[2].(string), BirthDate: values[3].(string)}
+		other := &entity.User{Id: values[4].(string), FirstName: values[5].(string), LastName: values[6].(string), BirthDate: values[7].(string)}
+		return fn(user, other)
+	})
+	span.SetAttributes(otlp.RowsAffected(pairs))
+
+	return err
+}
+
+// ReplaceDuplicates swaps the duplicates found by an earlier search for candidates in a single transaction
+func (p *userRepo) ReplaceDuplicates(ctx context.Context, candidates []*entity.DuplicateCandidate) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ReplaceDuplicates")
+	defer func() { span.EndError(err) }()
+
+	span.SetAttributes(otlp.DBAttributes(duplicateTableName, "COPY "+duplicateTableName)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err = tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s`, duplicateTableName)); err != nil {
+		return p.db.Error(err)
+	}
+	columns := []string{"user_id", "duplicate_id", "score", "found_at"}
+	copied, err := tx.CopyFrom(ctx, pgx.Identifier{duplicateTableName}, columns, pgx.CopyFromSlice(len(candidates), func(i int) ([]any, error) {
+		candidate := candidates[i]
+		return []any{candidate.User.Id, candidate.Duplicate.Id, candidate.Score, candidate.FoundAt}, nil
+	}))
+	if err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(copied))
+
+	return tx.Commit(ctx)
+}
+
+// ListDuplicates returns the duplicates between live users, the most similar pair first
+func (p *userRepo) ListDuplicates(ctx context.Context, limit, offset uint64) (_ []*entity.DuplicateCandidate, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ListDuplicates")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(
+			"d.score", "d.found_at",
+			"a.id", "a.first_name", "a.last_name", "a.birth_date", "a.phone_number", "a.email", "a.created_at",
+			"b.id", "b.first_name", "b.last_name", "b.birth_date", "b.phone_number", "b.email", "b.created_at",
+		).
+		From(duplicateTableName+" d").
+		Join(p.tableName+" a ON a.id = d.user_id AND a.deleted_at IS NULL").
+		Join(p.tableName+" b ON b.id = d.duplicate_id AND b.deleted_at IS NULL").
+		OrderBy("d.score DESC", "d.user_id", "d.duplicate_id").
+		Limit(limit).
+		Offset(offset).
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", duplicateTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(duplicateTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var candidates []*entity.DuplicateCandidate
+	for rows.Next() {
+		var (
+			candidate          = entity.DuplicateCandidate{User: &entity.User{}, Duplicate: &entity.User{}}
+			userBirthDate      time.Time
+			duplicateBirthDate time.Time
+			userEmail          sql.NullString
+			duplicateEmail     sql.NullString
+		)
+		if err = rows.Scan(
+			&candidate.Score,
+			&candidate.FoundAt,
+			&candidate.User.Id,
+			&candidate.User.FirstName,
+			&candidate.User.LastName,
+			&userBirthDate,
+			&candidate.User.PhoneNumber,
+			&userEmail,
+			&candidate.User.CreatedAt,
+			&candidate.Duplicate.Id,
+			&candidate.Duplicate.FirstName,
+			&candidate.Duplicate.LastName,
+			&duplicateBirthDate,
+			&candidate.Duplicate.PhoneNumber,
+			&duplicateEmail,
+			&candidate.Duplicate.CreatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		candidate.User.BirthDate = userBirthDate.Format("2006-01-02")
+		candidate.Duplicate.BirthDate = duplicateBirthDate.Format("2006-01-02")
+		candidate.User.Email = userEmail.String
+		candidate.Duplicate.Email = duplicateEmail.String
+		candidates = append(candidates, &candidate)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(candidates))))
+
+	return candidates, rows.Err()
+}
+
+// Merge moves the dependants, emergency contacts and consents of mergedId to survivorId and
+// soft-deletes mergedId pointing at survivorId, in a single transaction. The guardian of the merged
+// account and its session carry over when the survivor has none, so a device signed in to either
+// keeps working. Both users must be live and the survivor may not be a dependant of the merged account
+func (p *userRepo) Merge(ctx context.Context, survivorId, mergedId string) (_ time.Time, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Merge")
+	defer func() { span.EndError(err) }()
+
+	lockQuery := fmt.Sprintf(`
+		SELECT id, refresh_token FROM %s
+		WHERE id IN ($1, $2) AND deleted_at IS NULL
+		ORDER BY id
+		FOR UPDATE
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, lockQuery)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, lockQuery, survivorId, mergedId)
+	if err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+	refreshTokens := make(map[string]string, 2)
+	for rows.Next() {
+		var id, refreshToken string
+		if err = rows.Scan(&id, &refreshToken); err != nil {
+			rows.Close()
+			return time.Time{}, p.db.Error(err)
+		}
+		refreshTokens[id] = refreshToken
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+	if len(refreshTokens) != 2 {
+		return time.Time{}, entity.NewErrNotFound("user")
+	}
+
+	moves := []string{
+		fmt.Sprintf(`UPDATE %s SET guardian_id = $1, updated_at = NOW() WHERE guardian_id = $2`, dependantTableName),
+		fmt.Sprintf(`UPDATE %[1]s SET dependant_id = $1, updated_at = NOW()
+			WHERE dependant_id = $2 AND NOT EXISTS (SELECT 1 FROM %[1]s WHERE dependant_id = $1)`, dependantTableName),
+		fmt.Sprintf(`UPDATE %s SET user_id = $1 WHERE user_id = $2`, contactTableName),
+		fmt.Sprintf(`UPDATE %s SET user_id = $1 WHERE user_id = $2`, consentTableName),
+	}
+	var moved int64
+	for _, sqlStr := range moves {
+		tag, err := tx.Exec(ctx, sqlStr, survivorId, mergedId)
+		if err != nil {
+			return time.Time{}, p.db.Error(err)
+		}
+		moved += tag.RowsAffected()
+	}
+	// a pending verification would verify an email on an account that no longer exists
+	if _, err = tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, verificationTableName), mergedId); err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+	if refreshTokens[survivorId] == "" && refreshTokens[mergedId] != "" {
+		if _, err = tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET refresh_token = $1 WHERE id = $2`, p.tableName),
+			refreshTokens[mergedId], survivorId); err != nil {
+			return time.Time{}, p.db.Error(err)
+		}
+	}
+
+	var mergedAt time.Time
+	if err = tx.QueryRow(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NOW(),
+			merged_into = $1,
+			refresh_token = '',
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $2
+		RETURNING deleted_at
+	`, p.tableName), survivorId, mergedId).Scan(&mergedAt); err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+	if _, err = tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE $1 IN (user_id, duplicate_id)`, duplicateTableName), mergedId); err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(moved + 1))
+
+	return mergedAt, tx.Commit(ctx)
+}
+
+// GetMerge returns when mergedId was merged into survivorId, ErrNotFound when it was not
+func (p *userRepo) GetMerge(ctx context.Context, survivorId, mergedId string) (_ time.Time, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetMerge")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`SELECT deleted_at FROM %s WHERE id = $1 AND merged_into = $2`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	var mergedAt time.Time
+	if err = p.db.QueryRow(ctx, sqlStr, mergedId, survivorId).Scan(&mergedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, entity.NewErrNotFound("merge")
+		}
+		return time.Time{}, p.db.Error(err)
+	}
+
+	return mergedAt, nil
+}
@@ -0,0 +1,128 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MergeRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *MergeRepositoryTestSuite) TestMerge() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	var users []entity.User
+	for _, name := range []string{"mergedata", "mergedatta"} {
+		user := entity.User{
+			Id:          uuid.New().String(),
+			FirstName:   name,
+			LastName:    name,
+			BirthDate:   "1899-08-30",
+			PhoneNumber: uuid.New().String(),
+			Password:    "mergedata",
+			Gender:      "male",
+			CreatedAt:   time.Now().UTC(),
+		}
+		s.Require().NoError(userRepo.Create(ctx, &user))
+		users = append(users, user)
+	}
+	survivor, merged := users[0], users[1]
+
+	// check that both users born on the same day are paired once
+	var pairs [][2]*entity.User
+	err = userRepo.DuplicatePairs(ctx, func(user, other *entity.User) error {
+		if user.BirthDate == "1899-08-30" {
+			pairs = append(pairs, [2]*entity.User{user, other})
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(pairs, 1)
+	s.Suite.Less(pairs[0][0].Id, pairs[0][1].Id)
+
+	// check replace and list duplicates methods
+	s.Require().NoError(userRepo.ReplaceDuplicates(ctx, []*entity.DuplicateCandidate{
+		{User: pairs[0][0], Duplicate: pairs[0][1], Score: 0.95, FoundAt: time.Now().UTC()},
+	}))
+	candidates, err := userRepo.ListDuplicates(ctx, 1, 0)
+	s.Require().NoError(err)
+	s.Require().Len(candidates, 1)
+	s.Suite.InDelta(0.95, candidates[0].Score, 0.001)
+	s.Suite.Equal("1899-08-30", candidates[0].User.BirthDate)
+
+	// the merged user has a dependant, an emergency contact and a session
+	dependant := entity.Dependant{
+		GuardianId:   merged.Id,
+		Relationship: entity.DependantRelationshipChild,
+		User:         &entity.User{Id: uuid.New().String(), FirstName: "mergedata", LastName: "mergedata", BirthDate: "2018-08-30", Gender: "male"},
+	}
+	s.Require().NoError(userRepo.CreateDependant(ctx, &dependant))
+	contact := entity.EmergencyContact{Id: uuid.New().String(), UserId: merged.Id, FullName: "mergedata", PhoneNumber: "+998901234567"}
+	s.Require().NoError(userRepo.CreateEmergencyContact(ctx, &contact))
+	_, err = userRepo.UpdateRefreshToken(ctx, &entity.UpdateRefreshTokenReq{Id: merged.Id, RefreshToken: "mergedata"})
+	s.Require().NoError(err)
+
+	// check merge method
+	mergedAt, err := userRepo.Merge(ctx, survivor.Id, merged.Id)
+	s.Require().NoError(err)
+	s.Suite.False(mergedAt.IsZero())
+
+	var errNotFound *entity.ErrNotFound
+	_, err = userRepo.Get(ctx, map[string]string{"id": merged.Id})
+	s.Suite.ErrorAs(err, &errNotFound)
+	moved, err := userRepo.GetDependant(ctx, dependant.User.Id)
+	s.Require().NoError(err)
+	s.Suite.Equal(survivor.Id, moved.GuardianId)
+	contacts, err := userRepo.ListEmergencyContacts(ctx, survivor.Id)
+	s.Require().NoError(err)
+	s.Require().Len(contacts, 1)
+	s.Suite.Equal(contact.Id, contacts[0].Id)
+	personal, err := userRepo.GetPersonalData(ctx, survivor.Id)
+	s.Require().NoError(err)
+	s.Suite.Equal("mergedata", personal.RefreshToken)
+
+	candidates, err = userRepo.ListDuplicates(ctx, 100, 0)
+	s.Require().NoError(err)
+	for _, candidate := range candidates {
+		s.Suite.NotEqual(merged.Id, candidate.Duplicate.Id)
+		s.Suite.NotEqual(merged.Id, candidate.User.Id)
+	}
+
+	// a merged user is gone, the merge can be read back
+	_, err = userRepo.Merge(ctx, survivor.Id, merged.Id)
+	s.Suite.ErrorAs(err, &errNotFound)
+	mergedAgain, err := userRepo.GetMerge(ctx, survivor.Id, merged.Id)
+	s.Require().NoError(err)
+	s.Suite.True(mergedAt.Equal(mergedAgain))
+	_, err = userRepo.GetMerge(ctx, merged.Id, survivor.Id)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	s.Suite.NoError(userRepo.Delete(ctx, dependant.User.Id))
+	s.Suite.NoError(userRepo.Delete(ctx, survivor.Id))
+}
+
+func TestMergeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(MergeRepositoryTestSuite))
+}
@@ -0,0 +1,214 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	contactTableName      = "user_emergency_contacts"
+	verificationTableName = "user_email_verifications"
+	medicalTableName      = "medical_profiles"
+)
+
+func (p *userRepo) CreateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CreateEmergencyContact")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.Insert(contactTableName).
+		SetMap(map[string]any{
+			"id":           contact.Id,
+			"user_id":      contact.UserId,
+			"full_name":    contact.FullName,
+			"relationship": contact.Relationship,
+			"phone_number": contact.PhoneNumber,
+		}).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", contactTableName, "create"))
+	}
+	span.SetAttributes(otlp.DBAttributes(contactTableName, query)...)
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&contact.CreatedAt); err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// ListEmergencyContacts returns the contacts of a user, the oldest first
+func (p *userRepo) ListEmergencyContacts(ctx context.Context, userId string) (_ []*entity.EmergencyContact, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ListEmergencyContacts")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("id", "user_id", "full_name", "relationship", "phone_number", "created_at", "updated_at").
+		From(contactTableName).
+		Where(p.db.Sq.Equal("user_id", userId)).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", contactTableName, "list"))
+	}
+	span.SetAttributes(otlp.DBAttributes(contactTableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	var contacts []*entity.EmergencyContact
+	for rows.Next() {
+		var (
+			contact   entity.EmergencyContact
+			updatedAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&contact.Id,
+			&contact.UserId,
+			&contact.FullName,
+			&contact.Relationship,
+			&contact.PhoneNumber,
+			&contact.CreatedAt,
+			&updatedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+		if updatedAt.Valid {
+			contact.UpdatedAt = updatedAt.Time
+		}
+		contacts = append(contacts, &contact)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(contacts))))
+
+	return contacts, rows.Err()
+}
+
+func (p *userRepo) UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"UpdateEmergencyContact")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET full_name = $1, relationship = $2, phone_number = $3, updated_at = NOW()
+		WHERE id = $4 AND user_id = $5
+		RETURNING created_at, updated_at
+	`, contactTableName)
+	span.SetAttributes(otlp.DBAttributes(contactTableName, sqlStr)...)
+
+	if err = p.db.QueryRow(ctx, sqlStr, contact.FullName, contact.Relationship, contact.PhoneNumber, contact.Id, contact.UserId).
+		Scan(&contact.CreatedAt, &contact.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.NewErrNotFound("emergency contact")
+		}
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+func (p *userRepo) DeleteEmergencyContact(ctx context.Context, userId, id string) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"DeleteEmergencyContact")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`DELETE FROM %s WHERE id = $1 AND user_id = $2`, contactTableName)
+	span.SetAttributes(otlp.DBAttributes(contactTableName, sqlStr)...)
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, id, userId)
+	if err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("emergency contact")
+	}
+
+	return nil
+}
+
+func (p *userRepo) CreateEmailVerification(ctx context.Context, verification *entity.EmailVerification) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CreateEmailVerification")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.Insert(verificationTableName).
+		SetMap(map[string]any{
+			"id":         verification.Id,
+			"user_id":    verification.UserId,
+			"email":      verification.Email,
+			"token_hash": verification.TokenHash,
+			"expires_at": verification.ExpiresAt,
+		}).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", verificationTableName, "create"))
+	}
+	span.SetAttributes(otlp.DBAttributes(verificationTableName, query)...)
+
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&verification.CreatedAt); err != nil {
+		return p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
+
+// VerifyEmail uses up a pending verification and marks the email of its user verified,
+// it fails when the user changed its email since the verification was requested
+func (p *userRepo) VerifyEmail(ctx context.Context, tokenHash string) (_ string, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"VerifyEmail")
+	defer func() { span.EndError(err) }()
+
+	verificationQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET verified_at = NOW()
+		WHERE token_hash = $1
+		AND verified_at IS NULL
+		AND expires_at > NOW()
+		RETURNING user_id, email
+	`, verificationTableName)
+	userQuery := fmt.Sprintf(`
+		UPDATE %s
+		SET email_verified_at = NOW(), updated_at = NOW(), version = version + 1
+		WHERE id = $1
+		AND lower(email) = lower($2)
+		AND deleted_at IS NULL
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(verificationTableName, verificationQuery)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	var userId, email string
+	if err = tx.QueryRow(ctx, verificationQuery, tokenHash).Scan(&userId, &email); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", entity.NewErrNotFound("pending email verification")
+		}
+		return "", p.db.Error(err)
+	}
+
+	commandTag, err := tx.Exec(ctx, userQuery, userId, email)
+	if err != nil {
+		return "", p.db.Error(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return "", entity.NewErrNotFound("user with this email")
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	return userId, tx.Commit(ctx)
+}
@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserProfileRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *UserProfileRepositoryTestSuite) TestProfile() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	user := entity.User{
+		Id:                uuid.New().String(),
+		FirstName:         "profiledata",
+		LastName:          "profiledata",
+		BirthDate:         "2000-08-30",
+		PhoneNumber:       uuid.New().String(),
+		Password:          "profiledata",
+		Gender:            "male",
+		Email:             uuid.New().String() + "@dennic.uz",
+		PreferredLanguage: "uz",
+		City:              "Tashkent",
+		Country:           "UZ",
+		CreatedAt:         time.Now().UTC(),
+	}
+	s.Require().NoError(userRepo.Create(ctx, &user))
+
+	// check the profile filters
+	found, err := userRepo.List(ctx, 0, 0, map[string]string{"email": user.Email, "country": "UZ", "city": "Tashkent"})
+	s.Suite.NoError(err)
+	s.Suite.Len(found, 1)
+
+	// check emergency contact methods
+	contact := entity.EmergencyContact{
+		Id:          uuid.New().String(),
+		UserId:      user.Id,
+		FullName:    "profiledata",
+		PhoneNumber: "+998901234567",
+	}
+	s.Suite.NoError(userRepo.CreateEmergencyContact(ctx, &contact))
+	contact.Relationship = "mother"
+	s.Suite.NoError(userRepo.UpdateEmergencyContact(ctx, &contact))
+	contacts, err := userRepo.ListEmergencyContacts(ctx, user.Id)
+	s.Suite.NoError(err)
+	s.Require().Len(contacts, 1)
+	s.Suite.Equal(contacts[0].Relationship, "mother")
+	s.Suite.NoError(userRepo.DeleteEmergencyContact(ctx, user.Id, contact.Id))
+	var errNotFound *entity.ErrNotFound
+	s.Suite.ErrorAs(userRepo.DeleteEmergencyContact(ctx, user.Id, contact.Id), &errNotFound)
+
+	// check email verification methods
+	verification := entity.EmailVerification{
+		Id:        uuid.New().String(),
+		UserId:    user.Id,
+		Email:     user.Email,
+		TokenHash: uuid.New().String(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	s.Suite.NoError(userRepo.CreateEmailVerification(ctx, &verification))
+	userId, err := userRepo.VerifyEmail(ctx, verification.TokenHash)
+	s.Suite.NoError(err)
+	s.Suite.Equal(userId, user.Id)
+	verified, err := userRepo.Get(ctx, map[string]string{"id": user.Id})
+	s.Suite.NoError(err)
+	s.Suite.False(verified.EmailVerifiedAt.IsZero())
+	_, err = userRepo.VerifyEmail(ctx, verification.TokenHash)
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	// check that changing the email clears its verification
+	verified.Email = uuid.New().String() + "@dennic.uz"
+	s.Suite.NoError(userRepo.Update(ctx, verified, []string{"email"}))
+	changed, err := userRepo.Get(ctx, map[string]string{"id": user.Id})
+	s.Suite.NoError(err)
+	s.Suite.True(changed.EmailVerifiedAt.IsZero())
+
+	s.Suite.NoError(userRepo.Delete(ctx, user.Id))
+}
+
+func TestUserProfileRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserProfileRepositoryTestSuite))
+}
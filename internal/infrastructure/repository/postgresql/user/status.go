@@ -0,0 +1,48 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// SetStatus writes the status of user if its version still matches the stored one,
+// a user that is no longer active loses its refresh token so its sessions end
+func (p *userRepo) SetStatus(ctx context.Context, user *entity.User) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"SetStatus")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET status = $1,
+			status_reason = $2,
+			status_expires_at = $3,
+			refresh_token = CASE WHEN $1 = 'active' THEN refresh_token ELSE '' END,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $4
+		AND version = $5
+		AND deleted_at IS NULL
+		RETURNING version, updated_at
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	var expiresAt any
+	if !user.StatusExpiresAt.IsZero() {
+		expiresAt = user.StatusExpiresAt
+	}
+	if err = p.db.QueryRow(ctx, sqlStr, user.Status, user.StatusReason, expiresAt, user.Id, user.Version).
+		Scan(&user.Version, &user.UpdatedAt); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return p.db.Error(err)
+		}
+		return p.updateMissed(ctx, user.Id)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/config"
+	"dennic_user_service/internal/pkg/postgres"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserStatusRepositoryTestSuite struct {
+	suite.Suite
+	DB *postgres.PostgresDB
+}
+
+func (s *UserStatusRepositoryTestSuite) TestStatus() {
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
+
+	db, err := postgres.New(config)
+	if err != nil {
+		s.T().Fatal("Error initializing database connection:", err)
+	}
+
+	s.DB = db
+
+	userRepo := NewUserRepo(s.DB)
+	ctx := context.Background()
+
+	user := entity.User{
+		Id:           uuid.New().String(),
+		FirstName:    "statusdata",
+		LastName:     "statusdata",
+		BirthDate:    "2000-08-30",
+		PhoneNumber:  uuid.New().String(),
+		Password:     "statusdata",
+		Gender:       "male",
+		RefreshToken: "statusdata",
+		Status:       entity.UserStatusPending,
+		CreatedAt:    time.Now().UTC(),
+	}
+	s.Require().NoError(userRepo.Create(ctx, &user))
+
+	// check that a pending user can not refresh its session
+	var errInactive *entity.ErrAccountInactive
+	_, err = userRepo.UpdateRefreshToken(ctx, &entity.UpdateRefreshTokenReq{Id: user.Id, RefreshToken: "new"})
+	s.Suite.ErrorAs(err, &errInactive)
+
+	// check set status method and the status filter
+	got, err := userRepo.Get(ctx, map[string]string{"id": user.Id})
+	s.Require().NoError(err)
+	s.Suite.Equal(got.Status, entity.UserStatusPending)
+	got.Status = entity.UserStatusActive
+	s.Suite.NoError(userRepo.SetStatus(ctx, got))
+	active, err := userRepo.List(ctx, 0, 0, map[string]string{"id": user.Id, "status": entity.UserStatusActive})
+	s.Suite.NoError(err)
+	s.Suite.Len(active, 1)
+
+	// check that a suspended user can not change its password
+	got.Status = entity.UserStatusSuspended
+	got.StatusReason = "spam"
+	got.StatusExpiresAt = time.Now().Add(time.Hour)
+	s.Suite.NoError(userRepo.SetStatus(ctx, got))
+	_, err = userRepo.ChangePassword(ctx, &entity.ChangeUserPasswordReq{PhoneNumber: user.PhoneNumber, Password: "new"})
+	s.Suite.ErrorAs(err, &errInactive)
+
+	// check that a stale version is rejected
+	stale := *got
+	stale.Version--
+	var errPrecondition *entity.ErrPreconditionFailed
+	s.Suite.ErrorAs(userRepo.SetStatus(ctx, &stale), &errPrecondition)
+
+	// check that an expired suspension reads as active
+	got.StatusExpiresAt = time.Now().Add(-time.Minute)
+	s.Suite.NoError(userRepo.SetStatus(ctx, got))
+	lapsed, err := userRepo.Get(ctx, map[string]string{"id": user.Id})
+	s.Suite.NoError(err)
+	s.Suite.Equal(lapsed.Status, entity.UserStatusActive)
+	s.Suite.Empty(lapsed.StatusReason)
+
+	s.Suite.NoError(userRepo.Delete(ctx, user.Id))
+}
+
+func TestUserStatusRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserStatusRepositoryTestSuite))
+}
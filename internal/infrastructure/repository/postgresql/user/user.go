@@ -6,13 +6,17 @@ import (
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/pkg/otlp"
 	"dennic_user_service/internal/pkg/postgres"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
 	userTableName      = "users"
+	auditTableName     = "audit_log"
 	userServiceName    = "userService"
 	userSpanRepoPrefix = "userRepo"
 )
@@ -29,54 +33,149 @@ func NewUserRepo(db *postgres.PostgresDB) *userRepo {
 	}
 }
 
+// userStatusLapsed matches suspended and blocked users whose status expired, they read as active
+const userStatusLapsed = "status IN ('suspended', 'blocked') AND status_expires_at <= NOW()"
+
+// userStatus is the status of a user as it applies now
+const userStatus = "CASE WHEN " + userStatusLapsed + " THEN 'active' ELSE status END"
+
+var userColumns = []string{
+	"id",
+	"user_order",
+	"first_name",
+	"last_name",
+	"birth_date",
+	"phone_number",
+	"password",
+	"COALESCE(gender::text, '') AS gender",
+	"version",
+	"created_at",
+	"updated_at",
+	userStatus + " AS status",
+	"CASE WHEN " + userStatusLapsed + " THEN '' ELSE status_reason END AS status_reason",
+	"CASE WHEN " + userStatusLapsed + " THEN NULL ELSE status_expires_at END AS status_expires_at",
+	"email",
+	"email_verified_at",
+	"preferred_language",
+	"address_line",
+	"city",
+	"country",
+	"avatar_key",
+}
+
 func (p *userRepo) userSelectQueryPrefix() squirrel.SelectBuilder {
 	return p.db.Sq.Builder.
-		Select(
-			"id",
-			"user_order",
-			"first_name",
-			"last_name",
-			"birth_date",
-			"phone_number",
-			"password",
-			"gender",
-			"created_at",
-			"updated_at",
-		).From(p.tableName).
+		Select(userColumns...).
+		From(p.tableName).
 		Where("deleted_at IS NULL")
 }
 
-func (p userRepo) Create(ctx context.Context, user *entity.User) error {
+// userFilter applies the filters of listing, unknown keys are ignored
+func (p *userRepo) userFilter(queryBuilder squirrel.SelectBuilder, filter map[string]string) squirrel.SelectBuilder {
+	for key, value := range filter {
+		if key == "id" {
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+			continue
+		}
+		if key == "created_at" {
+			queryBuilder = queryBuilder.Where("created_at=?", value)
+			continue
+		}
+		if key == "status" {
+			queryBuilder = queryBuilder.Where(userStatus+" = ?", value)
+			continue
+		}
+		if key == "email" {
+			queryBuilder = queryBuilder.Where("lower(email) = lower(?)", value)
+			continue
+		}
+		if key == "city" || key == "country" || key == "preferred_language" {
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+			continue
+		}
+	}
+	return queryBuilder
+}
+
+func (p *userRepo) deletedUserSelectQueryPrefix() squirrel.SelectBuilder {
+	return p.db.Sq.Builder.
+		Select(append(userColumns, "deleted_at")...).
+		From(p.tableName).
+		Where("deleted_at IS NOT NULL")
+}
+
+func (p userRepo) Create(ctx context.Context, user *entity.User) (err error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Create")
-	defer span.End()
-	data := map[string]any{
-		"id":            user.Id,
-		"first_name":    user.FirstName,
-		"last_name":     user.LastName,
-		"birth_date":    user.BirthDate,
-		"phone_number":  user.PhoneNumber,
-		"password":      user.Password,
-		"gender":        user.Gender,
-		"refresh_token": user.RefreshToken,
-		"created_at":    user.CreatedAt,
-	}
-
-	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(data).ToSql()
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.Insert(p.tableName).SetMap(userInsertData(user)).ToSql()
 	if err != nil {
 		return p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "create"))
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
-	_, err = p.db.Exec(ctx, query, args...)
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	commandTag, err := tx.Exec(ctx, query, args...)
 	if err != nil {
 		return p.db.Error(err)
 	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
 
-	return nil
+	for _, consent := range user.Consents {
+		consentQuery, consentArgs, err := p.consentInsert(consent)
+		if err != nil {
+			return err
+		}
+		if err = tx.QueryRow(ctx, consentQuery, consentArgs...).Scan(&consent.AcceptedAt); err != nil {
+			return p.db.Error(err)
+		}
+	}
+
+	return tx.Commit(ctx)
 }
 
-func (p userRepo) Get(ctx context.Context, params map[string]string) (*entity.User, error) {
+// userInsertData are the columns a new user is inserted with
+func userInsertData(user *entity.User) map[string]any {
+	data := map[string]any{
+		"id":                 user.Id,
+		"first_name":         user.FirstName,
+		"last_name":          user.LastName,
+		"birth_date":         user.BirthDate,
+		"phone_number":       user.PhoneNumber,
+		"password":           user.Password,
+		"gender":             user.Gender,
+		"refresh_token":      user.RefreshToken,
+		"created_at":         user.CreatedAt,
+		"email":              nullString(user.Email),
+		"preferred_language": user.PreferredLanguage,
+		"address_line":       user.AddressLine,
+		"city":               user.City,
+		"country":            user.Country,
+		"avatar_key":         user.AvatarKey,
+	}
+	// the column defaults to active
+	if user.Status != "" {
+		data["status"] = user.Status
+	}
+	return data
+}
+
+// nullString stores an empty optional value as NULL, so unique indexes skip it
+func nullString(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func (p userRepo) Get(ctx context.Context, params map[string]string) (_ *entity.User, err error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Get")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	var (
 		user entity.User
@@ -93,10 +192,14 @@ func (p userRepo) Get(ctx context.Context, params map[string]string) (*entity.Us
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get"))
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
 	var (
-		birthDate sql.NullString
-		updatedAt sql.NullTime
+		birthDate       sql.NullString
+		updatedAt       sql.NullTime
+		statusExpiresAt sql.NullTime
+		email           sql.NullString
+		emailVerifiedAt sql.NullTime
 	)
 	if err = p.db.QueryRow(ctx, query, args...).Scan(
 		&user.Id,
@@ -107,8 +210,19 @@ func (p userRepo) Get(ctx context.Context, params map[string]string) (*entity.Us
 		&user.PhoneNumber,
 		&user.Password,
 		&user.Gender,
+		&user.Version,
 		&user.CreatedAt,
 		&updatedAt,
+		&user.Status,
+		&user.StatusReason,
+		&statusExpiresAt,
+		&email,
+		&emailVerifiedAt,
+		&user.PreferredLanguage,
+		&user.AddressLine,
+		&user.City,
+		&user.Country,
+		&user.AvatarKey,
 	); err != nil {
 		return nil, p.db.Error(err)
 	}
@@ -119,13 +233,46 @@ func (p userRepo) Get(ctx context.Context, params map[string]string) (*entity.Us
 	if updatedAt.Valid {
 		user.UpdatedAt = updatedAt.Time
 	}
+	if statusExpiresAt.Valid {
+		user.StatusExpiresAt = statusExpiresAt.Time
+	}
+	if email.Valid {
+		user.Email = email.String
+	}
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = emailVerifiedAt.Time
+	}
 
 	return &user, nil
 }
 
-func (p userRepo) List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error) {
+// GetPassword reads the password hash of a user by id, the cache keeps users without it
+func (p *userRepo) GetPassword(ctx context.Context, id string) (_ string, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetPassword")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select("password").
+		From(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		return "", p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get password"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var password string
+	if err = p.db.QueryRow(ctx, query, args...).Scan(&password); err != nil {
+		return "", p.db.Error(err)
+	}
+
+	return password, nil
+}
+
+func (p userRepo) List(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.User, err error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"List")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	var (
 		users []*entity.User
@@ -136,21 +283,13 @@ func (p userRepo) List(ctx context.Context, limit, offset uint64, filter map[str
 		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
 	}
 
-	for key, value := range filter {
-		if key == "id" {
-			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
-			continue
-		}
-		if key == "created_at" {
-			queryBuilder = queryBuilder.Where("created_at=?", value)
-			continue
-		}
-	}
+	queryBuilder = p.userFilter(queryBuilder, filter)
 
 	query, args, err := queryBuilder.ToSql()
 	if err != nil {
 		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list"))
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
 	rows, err := p.db.Query(ctx, query, args...)
 	if err != nil {
@@ -158,12 +297,15 @@ func (p userRepo) List(ctx context.Context, limit, offset uint64, filter map[str
 	}
 	defer rows.Close()
 
-	var (
-		birthDate sql.NullTime
-		updatedAt sql.NullTime
-	)
 	for rows.Next() {
-		var user entity.User
+		var (
+			user            entity.User
+			birthDate       sql.NullTime
+			updatedAt       sql.NullTime
+			statusExpiresAt sql.NullTime
+			email           sql.NullString
+			emailVerifiedAt sql.NullTime
+		)
 		if err = rows.Scan(
 			&user.Id,
 			&user.UserOrder,
@@ -173,8 +315,19 @@ func (p userRepo) List(ctx context.Context, limit, offset uint64, filter map[str
 			&user.PhoneNumber,
 			&user.Password,
 			&user.Gender,
+			&user.Version,
 			&user.CreatedAt,
 			&updatedAt,
+			&user.Status,
+			&user.StatusReason,
+			&statusExpiresAt,
+			&email,
+			&emailVerifiedAt,
+			&user.PreferredLanguage,
+			&user.AddressLine,
+			&user.City,
+			&user.Country,
+			&user.AvatarKey,
 		); err != nil {
 			return nil, p.db.Error(err)
 		}
@@ -185,63 +338,114 @@ func (p userRepo) List(ctx context.Context, limit, offset uint64, filter map[str
 		if updatedAt.Valid {
 			user.UpdatedAt = updatedAt.Time
 		}
+		if statusExpiresAt.Valid {
+			user.StatusExpiresAt = statusExpiresAt.Time
+		}
+		if email.Valid {
+			user.Email = email.String
+		}
+		if emailVerifiedAt.Valid {
+			user.EmailVerifiedAt = emailVerifiedAt.Time
+		}
 		users = append(users, &user)
 	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(users))))
 
 	return users, nil
 }
 
-func (p userRepo) Update(ctx context.Context, user *entity.User) error {
+// Update writes the listed fields of user only if its version still matches the stored one
+// and bumps the version, a stale version fails with entity.ErrPreconditionFailed
+func (p userRepo) Update(ctx context.Context, user *entity.User, fields []string) (err error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Update")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 	clauses := map[string]any{
-		"first_name":   user.FirstName,
-		"last_name":    user.LastName,
-		"birth_date":   user.BirthDate,
-		"phone_number": user.PhoneNumber,
-		"password":     user.Password,
-		"gender":       user.Gender,
-		"updated_at":   user.UpdatedAt,
+		"first_name":         user.FirstName,
+		"last_name":          user.LastName,
+		"birth_date":         user.BirthDate,
+		"phone_number":       user.PhoneNumber,
+		"gender":             user.Gender,
+		"email":              nullString(user.Email),
+		"preferred_language": user.PreferredLanguage,
+		"address_line":       user.AddressLine,
+		"city":               user.City,
+		"country":            user.Country,
+		"avatar_key":         user.AvatarKey,
+	}
+
+	setMap := map[string]interface{}{
+		"updated_at": user.UpdatedAt,
+		"version":    squirrel.Expr("version + 1"),
+	}
+	for _, field := range fields {
+		value, ok := clauses[field]
+		if !ok {
+			return fmt.Errorf("user field %s can not be updated", field)
+		}
+		setMap[field] = value
+	}
+	// a new email has to be verified again
+	if _, ok := setMap["email"]; ok {
+		setMap["email_verified_at"] = squirrel.Expr("CASE WHEN lower(email) IS NOT DISTINCT FROM lower(?::text) THEN email_verified_at END", nullString(user.Email))
 	}
 
 	updateBuilder := p.db.Sq.Builder.
 		Update(p.tableName).
-		SetMap(clauses).
-		Where(p.db.Sq.Equal("id", user.Id))
+		SetMap(setMap).
+		Where(p.db.Sq.Equal("id", user.Id)).
+		Where(p.db.Sq.Equal("version", user.Version))
 
-	updateBuilder = updateBuilder.Where("deleted_at IS NULL")
+	updateBuilder = updateBuilder.Where("deleted_at IS NULL").Suffix("RETURNING version")
 
 	sqlStr, args, err := updateBuilder.ToSql()
 	if err != nil {
 		return p.db.ErrSQLBuild(err, p.tableName+" update")
 	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
 
-	commandTag, err := p.db.Exec(ctx, sqlStr, args...)
-	if err != nil {
-		return p.db.Error(err)
+	var version uint64
+	if err = p.db.QueryRow(ctx, sqlStr, args...).Scan(&version); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return p.db.Error(err)
+		}
+		return p.updateMissed(ctx, user.Id)
 	}
+	span.SetAttributes(otlp.RowsAffected(1))
+	user.Version = version
 
-	if commandTag.RowsAffected() == 0 {
-		return p.db.Error(fmt.Errorf("no sql rows"))
+	return nil
+}
+
+// updateMissed tells a deleted or unknown user apart from one updated by someone else
+func (p userRepo) updateMissed(ctx context.Context, id string) error {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND deleted_at IS NULL)`, p.tableName)
+	if err := p.db.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return p.db.Error(err)
 	}
 
-	return nil
+	if exists {
+		return entity.NewErrPreconditionFailed("user")
+	}
+	return entity.NewErrNotFound("user")
 }
 
-func (p *userRepo) Delete(ctx context.Context, id string) error {
+func (p *userRepo) Delete(ctx context.Context, id string) (err error) {
 	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Delete")
-	defer span.End()
+	defer func() { span.EndError(err) }()
 
 	sqlStr := fmt.Sprintf(`
 		UPDATE %s
 		SET deleted_at = NOW()
 		WHERE id = $1 AND deleted_at IS NULL
 	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
 
 	commandTag, err := p.db.Exec(ctx, sqlStr, id)
 	if err != nil {
 		return p.db.Error(err)
 	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
 
 	if commandTag.RowsAffected() == 0 {
 		return p.db.Error(fmt.Errorf("no sql rows"))
@@ -250,12 +454,17 @@ func (p *userRepo) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (p *userRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
+func (p *userRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (_ *entity.CheckFieldResp, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"CheckField")
+	defer func() { span.EndError(err) }()
+
 	query := fmt.Sprintf(
 		`SELECT count(1) 
 		FROM users WHERE %s = $1 
 		AND deleted_at IS NULL`, req.Field)
 
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
 	var isExists int
 
 	row := p.db.QueryRow(ctx, query, req.Value)
@@ -275,12 +484,18 @@ func (p *userRepo) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*
 }
 
 func (p *userRepo) IfExists(ctx context.Context, req *entity.IfExistsReq) (resp *entity.IfExistsResp, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"IfExists")
+	defer func() { span.EndError(err) }()
+
 	query := `
 		SELECT EXISTS(
 		SELECT 1 FROM users 
 		WHERE phone_number = $1 
+		AND phone_number <> ''
 		AND deleted_at IS NULL)
 	`
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
 	var exists bool
 	row := p.db.QueryRow(ctx, query, req.PhoneNumber)
 	if err = row.Scan(&exists); err != nil {
@@ -293,37 +508,386 @@ func (p *userRepo) IfExists(ctx context.Context, req *entity.IfExistsReq) (resp
 	return resp, nil
 }
 
-func (p *userRepo) ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error) {
+func (p *userRepo) ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (_ *entity.ChangePasswordResp, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ChangePassword")
+	defer func() { span.EndError(err) }()
+
 	query := `
 		UPDATE users 
-		SET password = $1 
+		SET password = $1, version = version + 1 
 		WHERE phone_number = $2 
+		AND phone_number <> ''
 		AND deleted_at IS NULL
+		AND ` + userStatus + ` = 'active'
+		RETURNING id
 	`
-	resp, err := p.db.Exec(ctx, query, req.Password, req.PhoneNumber)
-	if err != nil {
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var id string
+	if err = p.db.QueryRow(ctx, query, req.Password, req.PhoneNumber).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if err = p.inactive(ctx, "phone_number", req.PhoneNumber); err != nil {
+				return nil, err
+			}
+			return &entity.ChangePasswordResp{Status: false}, nil
+		}
 		return nil, err
 	}
-	if resp.RowsAffected() == 0 {
-		return &entity.ChangePasswordResp{Status: false}, nil
-	}
-	return &entity.ChangePasswordResp{Status: true}, nil
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	return &entity.ChangePasswordResp{Id: id, Status: true}, nil
 }
 
-func (p *userRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
+func (p *userRepo) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (_ *entity.UpdateRefreshTokenResp, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"UpdateRefreshToken")
+	defer func() { span.EndError(err) }()
+
 	query := `
 			UPDATE users 
 			SET refresh_token = $1 
 			WHERE id = $2 AND 
-			deleted_at IS NULL`
+			deleted_at IS NULL AND 
+			id NOT IN (SELECT dependant_id FROM user_dependants) AND 
+			` + userStatus + ` = 'active'`
+
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
 
 	resp, err := p.db.Exec(ctx, query, req.RefreshToken, req.Id)
 	if err != nil {
 		return nil, err
 	}
+	span.SetAttributes(otlp.RowsAffected(resp.RowsAffected()))
 	if resp.RowsAffected() == 0 {
+		if err = p.inactive(ctx, "id", req.Id); err != nil {
+			return nil, err
+		}
 		return &entity.UpdateRefreshTokenResp{Status: false}, nil
 	}
 
 	return &entity.UpdateRefreshTokenResp{Status: true}, nil
 }
+
+// inactive reports entity.ErrAccountInactive when the live user with column = value
+// is not active, it returns nil for an active or unknown user
+func (p *userRepo) inactive(ctx context.Context, column, value string) error {
+	query := fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $1 AND deleted_at IS NULL`, userStatus, p.tableName, column)
+
+	var status string
+	if err := p.db.QueryRow(ctx, query, value).Scan(&status); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return p.db.Error(err)
+	}
+	if status != entity.UserStatusActive {
+		return entity.NewErrAccountInactive(status)
+	}
+	return nil
+}
+
+func (p userRepo) ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) (_ []*entity.User, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"ListDeleted")
+	defer func() { span.EndError(err) }()
+
+	var (
+		users []*entity.User
+	)
+	queryBuilder := p.deletedUserSelectQueryPrefix().OrderBy("deleted_at DESC")
+
+	if limit != 0 {
+		queryBuilder = queryBuilder.Limit(limit).Offset(offset)
+	}
+
+	for key, value := range filter {
+		switch key {
+		case "phone_number":
+			queryBuilder = queryBuilder.Where(p.db.Sq.Equal(key, value))
+		case "deleted_after":
+			queryBuilder = queryBuilder.Where("deleted_at >= ?", value)
+		case "deleted_before":
+			queryBuilder = queryBuilder.Where("deleted_at < ?", value)
+		}
+	}
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "list deleted"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, p.db.Error(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			user            entity.User
+			birthDate       sql.NullTime
+			updatedAt       sql.NullTime
+			statusExpiresAt sql.NullTime
+			email           sql.NullString
+			emailVerifiedAt sql.NullTime
+		)
+		if err = rows.Scan(
+			&user.Id,
+			&user.UserOrder,
+			&user.FirstName,
+			&user.LastName,
+			&birthDate,
+			&user.PhoneNumber,
+			&user.Password,
+			&user.Gender,
+			&user.Version,
+			&user.CreatedAt,
+			&updatedAt,
+			&user.Status,
+			&user.StatusReason,
+			&statusExpiresAt,
+			&email,
+			&emailVerifiedAt,
+			&user.PreferredLanguage,
+			&user.AddressLine,
+			&user.City,
+			&user.Country,
+			&user.AvatarKey,
+			&user.DeletedAt,
+		); err != nil {
+			return nil, p.db.Error(err)
+		}
+
+		if birthDate.Valid {
+			user.BirthDate = birthDate.Time.Format("2006-01-02")
+		}
+		if updatedAt.Valid {
+			user.UpdatedAt = updatedAt.Time
+		}
+		if statusExpiresAt.Valid {
+			user.StatusExpiresAt = statusExpiresAt.Time
+		}
+		if email.Valid {
+			user.Email = email.String
+		}
+		if emailVerifiedAt.Valid {
+			user.EmailVerifiedAt = emailVerifiedAt.Time
+		}
+		users = append(users, &user)
+	}
+	span.SetAttributes(otlp.RowsAffected(int64(len(users))))
+
+	return users, nil
+}
+
+// Restore undoes a soft delete, it fails with a conflict when the phone number
+// was taken by another account in the meantime
+func (p *userRepo) Restore(ctx context.Context, id string) (err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Restore")
+	defer func() { span.EndError(err) }()
+
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET deleted_at = NULL, updated_at = NOW(), version = version + 1
+		WHERE id = $1 AND deleted_at IS NOT NULL AND erased_at IS NULL
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, id)
+	if err != nil {
+		if err = p.db.Error(err); errors.Is(err, entity.ErrorConflict) {
+			return entity.NewErrConflict("user with this phone number")
+		}
+		return err
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	if commandTag.RowsAffected() == 0 {
+		return entity.NewErrNotFound("deleted user")
+	}
+
+	return nil
+}
+
+// Purge erases users soft-deleted longer than retention ago. Erased users are kept, their id has to
+// stay valid for the records of other services, and so are guardians until their dependants are
+// transferred or purged, a dependant can not sign in to look after itself
+func (p *userRepo) Purge(ctx context.Context, retention time.Duration) (_ int64, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Purge")
+	defer func() { span.EndError(err) }()
+
+	// deleted_at is written with NOW(), so the cutoff uses the database clock as well
+	sqlStr := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE deleted_at IS NOT NULL
+		AND deleted_at < NOW() - $1::interval
+		AND erased_at IS NULL
+		AND NOT EXISTS (SELECT 1 FROM %s WHERE guardian_id = %[1]s.id)
+	`, p.tableName, dependantTableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	commandTag, err := p.db.Exec(ctx, sqlStr, retention)
+	if err != nil {
+		return 0, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(commandTag.RowsAffected()))
+
+	return commandTag.RowsAffected(), nil
+}
+
+// erasedAt returns when a user was erased, ErrNotFound when it never was
+func (p *userRepo) erasedAt(ctx context.Context, id string) (time.Time, error) {
+	var erasedAt time.Time
+	sqlStr := fmt.Sprintf(`SELECT erased_at FROM %s WHERE id = $1 AND erased_at IS NOT NULL`, p.tableName)
+	if err := p.db.QueryRow(ctx, sqlStr, id).Scan(&erasedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, entity.NewErrNotFound("user")
+		}
+		return time.Time{}, p.db.Error(err)
+	}
+	return erasedAt, nil
+}
+
+// GetPersonalData reads a user, soft-deleted or not, with the session data a data export includes
+func (p *userRepo) GetPersonalData(ctx context.Context, id string) (_ *entity.User, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"GetPersonalData")
+	defer func() { span.EndError(err) }()
+
+	query, args, err := p.db.Sq.Builder.
+		Select(append(userColumns, "refresh_token", "deleted_at")...).
+		From(p.tableName).
+		Where(p.db.Sq.Equal("id", id)).
+		Where("erased_at IS NULL").
+		ToSql()
+	if err != nil {
+		return nil, p.db.ErrSQLBuild(err, fmt.Sprintf("%s %s", p.tableName, "get personal data"))
+	}
+	span.SetAttributes(otlp.DBAttributes(p.tableName, query)...)
+
+	var (
+		user            entity.User
+		birthDate       sql.NullTime
+		updatedAt       sql.NullTime
+		statusExpiresAt sql.NullTime
+		email           sql.NullString
+		emailVerifiedAt sql.NullTime
+		deletedAt       sql.NullTime
+	)
+	if err = p.db.QueryRow(ctx, query, args...).Scan(
+		&user.Id,
+		&user.UserOrder,
+		&user.FirstName,
+		&user.LastName,
+		&birthDate,
+		&user.PhoneNumber,
+		&user.Password,
+		&user.Gender,
+		&user.Version,
+		&user.CreatedAt,
+		&updatedAt,
+		&user.Status,
+		&user.StatusReason,
+		&statusExpiresAt,
+		&email,
+		&emailVerifiedAt,
+		&user.PreferredLanguage,
+		&user.AddressLine,
+		&user.City,
+		&user.Country,
+		&user.AvatarKey,
+		&user.RefreshToken,
+		&deletedAt,
+	); err != nil {
+		return nil, p.db.Error(err)
+	}
+
+	if birthDate.Valid {
+		user.BirthDate = birthDate.Time.Format("2006-01-02")
+	}
+	if updatedAt.Valid {
+		user.UpdatedAt = updatedAt.Time
+	}
+	if statusExpiresAt.Valid {
+		user.StatusExpiresAt = statusExpiresAt.Time
+	}
+	if email.Valid {
+		user.Email = email.String
+	}
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = emailVerifiedAt.Time
+	}
+	if deletedAt.Valid {
+		user.DeletedAt = deletedAt.Time
+	}
+
+	return &user, nil
+}
+
+// Erase overwrites the personal data of a user, including the values in its audit log, and
+// soft-deletes it, the id stays valid so records in other services keep pointing at it. Erasing
+// an erased user returns when it was erased, so a lost user.erased event can be sent again
+func (p *userRepo) Erase(ctx context.Context, id string) (_ time.Time, err error) {
+	ctx, span := otlp.Start(ctx, userServiceName, userSpanRepoPrefix+"Erase")
+	defer func() { span.EndError(err) }()
+
+	// phone_number has to stay unique among live rows and the column is NOT NULL, so it becomes the id
+	sqlStr := fmt.Sprintf(`
+		UPDATE %s
+		SET first_name = 'erased',
+			last_name = 'erased',
+			birth_date = '1900-01-01',
+			phone_number = 'erased:' || id::text,
+			password = '',
+			refresh_token = '',
+			gender = NULL,
+			email = NULL,
+			email_verified_at = NULL,
+			address_line = '',
+			city = '',
+			country = '',
+			preferred_language = '',
+			avatar_key = '',
+			deleted_at = COALESCE(deleted_at, NOW()),
+			updated_at = NOW(),
+			erased_at = NOW(),
+			version = version + 1
+		WHERE id = $1 AND erased_at IS NULL
+		RETURNING erased_at
+	`, p.tableName)
+	span.SetAttributes(otlp.DBAttributes(p.tableName, sqlStr)...)
+
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var erasedAt time.Time
+	if err = tx.QueryRow(ctx, sqlStr, id).Scan(&erasedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return p.erasedAt(ctx, id)
+		}
+		return time.Time{}, p.db.Error(err)
+	}
+	span.SetAttributes(otlp.RowsAffected(1))
+
+	// the contacts, verifications and the medical profile hold personal data of their own
+	for _, table := range []string{contactTableName, verificationTableName, medicalTableName} {
+		if _, err = tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE user_id = $1`, table), id); err != nil {
+			return time.Time{}, p.db.Error(err)
+		}
+	}
+	// consents stay as proof of what was agreed to, without the address they were given from
+	if _, err = tx.Exec(ctx, fmt.Sprintf(`UPDATE %s SET ip = '' WHERE user_id = $1`, consentTableName), id); err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+	// the audit log keeps which fields of the user changed and when, not their values
+	if _, err = tx.Exec(ctx, fmt.Sprintf(`
+		UPDATE %s
+		SET changes = (SELECT COALESCE(jsonb_object_agg(key, '{}'::jsonb), '{}'::jsonb) FROM jsonb_each(changes))
+		WHERE entity_type = $1 AND entity_id = $2
+	`, auditTableName), entity.AuditEntityUser, id); err != nil {
+		return time.Time{}, p.db.Error(err)
+	}
+
+	return erasedAt, tx.Commit(ctx)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"dennic_user_service/internal/entity"
 	repo "dennic_user_service/internal/infrastructure/repository"
+	auditrepo "dennic_user_service/internal/infrastructure/repository/postgresql/audit"
 	"dennic_user_service/internal/pkg/config"
 	"dennic_user_service/internal/pkg/postgres"
 	"testing"
@@ -33,7 +34,10 @@ func NewUserService(ctxTimeout time.Duration, repo repo.UserStorageI, config *co
 // test func
 func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 
-	config := config.New()
+	config, err := config.New()
+	if err != nil {
+		s.T().Fatal("Error loading config:", err)
+	}
 
 	db, err := postgres.New(config)
 	if err != nil {
@@ -85,15 +89,31 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 	s.Suite.Equal(getUser.FirstName, user.FirstName)
 	s.Suite.Equal(getUser.PhoneNumber, user.PhoneNumber)
 
+	// check get password method
+	password, err := userRepo.GetPassword(ctx, user.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(getUser.Password, password)
+
 	// check update user method
-	err = userRepo.Update(ctx, &updUser)
+	updUser.Version = getUser.Version
+	err = userRepo.Update(ctx, &updUser, []string{"first_name", "last_name", "birth_date", "phone_number", "gender"})
 	s.Suite.NoError(err)
+	s.Suite.Equal(getUser.Version+1, updUser.Version)
 	updGetUser, err := userRepo.Get(ctx, Params)
 	s.Suite.NoError(err)
 	s.Suite.NotNil(updGetUser)
+	s.Suite.Equal(updUser.Version, updGetUser.Version)
+
+	// check update with a stale version
+	stale := updUser
+	stale.Version = getUser.Version
+	err = userRepo.Update(ctx, &stale, []string{"first_name"})
+	var errPrecondition *entity.ErrPreconditionFailed
+	s.Suite.ErrorAs(err, &errPrecondition)
 	s.Suite.Equal(updGetUser.Id, updUser.Id)
 	s.Suite.Equal(updGetUser.FirstName, updUser.FirstName)
 	s.Suite.Equal(updGetUser.PhoneNumber, updUser.PhoneNumber)
+	s.Suite.Equal(updGetUser.Password, user.Password)
 
 	// check getAllUsers method
 	getAllUsers, err := userRepo.List(ctx, 5, 1, nil)
@@ -128,6 +148,7 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 	s.Suite.NoError(err)
 	s.Suite.NotNil(resp_change_password)
 	s.Suite.Equal(resp_change_password.Status, true)
+	s.Suite.Equal(resp_change_password.Id, user.Id)
 
 	// check UpdateRefreshToken user method
 	req_update_refresh_token := entity.UpdateRefreshTokenReq{
@@ -143,6 +164,65 @@ func (s *UserReposisitoryTestSuite) TestUserCRUD() {
 	err = userRepo.Delete(ctx, user.Id)
 	s.Suite.NoError(err)
 
+	// check listDeleted user method
+	deleted, err := userRepo.ListDeleted(ctx, 10, 0, map[string]string{"phone_number": updUser.PhoneNumber})
+	s.Suite.NoError(err)
+	s.Suite.NotEmpty(deleted)
+	s.Suite.Equal(deleted[0].Id, user.Id)
+	s.Suite.False(deleted[0].DeletedAt.IsZero())
+
+	// check restore user method
+	err = userRepo.Restore(ctx, user.Id)
+	s.Suite.NoError(err)
+	_, err = userRepo.Get(ctx, Params)
+	s.Suite.NoError(err)
+	err = userRepo.Restore(ctx, user.Id)
+	var errNotFound *entity.ErrNotFound
+	s.Suite.ErrorAs(err, &errNotFound)
+
+	err = userRepo.Delete(ctx, user.Id)
+	s.Suite.NoError(err)
+
+	// check getPersonalData user method, deleted users are still exported
+	personal, err := userRepo.GetPersonalData(ctx, user.Id)
+	s.Suite.NoError(err)
+	s.Suite.Equal(personal.FirstName, updUser.FirstName)
+	s.Suite.False(personal.DeletedAt.IsZero())
+
+	// check erase user method, the audit log keeps the changed fields without their values
+	auditRepo := auditrepo.NewAuditRepo(s.DB)
+	s.Require().NoError(auditRepo.Create(ctx, &entity.AuditEntry{
+		EntityType: entity.AuditEntityUser,
+		EntityId:   user.Id,
+		Action:     entity.AuditActionUpdate,
+		Changes:    map[string]entity.AuditChange{"first_name": {Before: user.FirstName, After: updUser.FirstName}},
+	}))
+	erasedAt, err := userRepo.Erase(ctx, user.Id)
+	s.Suite.NoError(err)
+	s.Suite.False(erasedAt.IsZero())
+	entries, err := auditRepo.List(ctx, 0, 0, map[string]string{"entity_type": entity.AuditEntityUser, "entity_id": user.Id})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(entries)
+	for _, entry := range entries {
+		for field, change := range entry.Changes {
+			s.Suite.Empty(change, field)
+		}
+	}
+	s.Suite.Contains(entries[len(entries)-1].Changes, "first_name")
+	_, err = userRepo.GetPersonalData(ctx, user.Id)
+	s.Suite.ErrorAs(err, &errNotFound)
+	erased, err := userRepo.ListDeleted(ctx, 0, 0, map[string]string{"phone_number": "erased:" + user.Id})
+	s.Require().NoError(err)
+	s.Require().Len(erased, 1)
+	s.Suite.Empty(erased[0].Gender)
+	s.Suite.Empty(erased[0].Country)
+	s.Suite.Empty(erased[0].PreferredLanguage)
+	// erasing again returns the first erasure, so its event can be sent again
+	erasedAgain, err := userRepo.Erase(ctx, user.Id)
+	s.Suite.NoError(err)
+	s.Suite.True(erasedAt.Equal(erasedAgain))
+	_, err = userRepo.Erase(ctx, uuid.New().String())
+	s.Suite.ErrorAs(err, &errNotFound)
 }
 
 func TestExampleUserTestSuite(t *testing.T) {
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+)
+
+type RoleStorageI interface {
+	Create(ctx context.Context, role *entity.Role) error
+	Get(ctx context.Context, id string) (*entity.Role, error)
+	List(ctx context.Context, limit, offset uint64) ([]*entity.Role, error)
+	Update(ctx context.Context, role *entity.Role) error
+	Delete(ctx context.Context, id string) error
+	Assign(ctx context.Context, adminId, roleId, actorId string) error
+	Revoke(ctx context.Context, adminId, roleId, actorId string) error
+	ListAdminRoles(ctx context.Context, adminId string) ([]*entity.Role, error)
+	ListAssignmentHistory(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.RoleAssignment, error)
+	AdminPermissions(ctx context.Context, adminId string) ([]string, error)
+}
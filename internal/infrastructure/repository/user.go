@@ -3,16 +3,48 @@ package repository
 import (
 	"context"
 	"dennic_user_service/internal/entity"
+	"time"
 )
 
 type UserStorageI interface {
 	Create(ctx context.Context, user *entity.User) error
 	Get(ctx context.Context, params map[string]string) (*entity.User, error)
+	GetPassword(ctx context.Context, id string) (string, error)
 	List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error)
-	Update(ctx context.Context, kyc *entity.User) error
+	Update(ctx context.Context, kyc *entity.User, fields []string) error
 	Delete(ctx context.Context, id string) error
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	IfExists(ctx context.Context, req *entity.IfExistsReq) (*entity.IfExistsResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error)
 	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	GetPersonalData(ctx context.Context, id string) (*entity.User, error)
+	Erase(ctx context.Context, id string) (time.Time, error)
+	SetStatus(ctx context.Context, user *entity.User) error
+	CreateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) error
+	ListEmergencyContacts(ctx context.Context, userId string) ([]*entity.EmergencyContact, error)
+	UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) error
+	DeleteEmergencyContact(ctx context.Context, userId, id string) error
+	CreateEmailVerification(ctx context.Context, verification *entity.EmailVerification) error
+	VerifyEmail(ctx context.Context, tokenHash string) (string, error)
+	CreateDependant(ctx context.Context, dependant *entity.Dependant) error
+	ListDependants(ctx context.Context, guardianId string) ([]*entity.Dependant, error)
+	GetDependant(ctx context.Context, dependantId string) (*entity.Dependant, error)
+	TransferDependant(ctx context.Context, dependant *entity.Dependant) error
+	PublishConsentDocument(ctx context.Context, document *entity.ConsentDocument) error
+	GetConsentDocument(ctx context.Context, documentType string, version uint64) (*entity.ConsentDocument, error)
+	CreateConsent(ctx context.Context, consent *entity.Consent) error
+	ListConsents(ctx context.Context, userId string) ([]*entity.Consent, error)
+	WithdrawConsent(ctx context.Context, userId, documentType string) ([]*entity.Consent, error)
+	FindByContacts(ctx context.Context, phoneNumbers, emails []string) ([]*entity.User, error)
+	CopyUsers(ctx context.Context, users []*entity.User) (int64, error)
+	Export(ctx context.Context, columns []string, filter map[string]string, fn func([]any) error) error
+	GetMany(ctx context.Context, ids []string) ([]*entity.User, error)
+	DuplicatePairs(ctx context.Context, fn func(user, other *entity.User) error) error
+	ReplaceDuplicates(ctx context.Context, candidates []*entity.DuplicateCandidate) error
+	ListDuplicates(ctx context.Context, limit, offset uint64) ([]*entity.DuplicateCandidate, error)
+	Merge(ctx context.Context, survivorId, mergedId string) (time.Time, error)
+	GetMerge(ctx context.Context, survivorId, mergedId string) (time.Time, error)
 }
@@ -6,6 +6,9 @@ import (
 
 type ctxKeyLocalization int
 
+// Version is set at build time with -ldflags "-X dennic_user_service/internal/pkg/app.Version=..."
+var Version = "dev"
+
 const (
 	EnvironmentProduction                    = "production"
 	EnvironmentDevelop                       = "develop"
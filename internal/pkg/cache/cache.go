@@ -0,0 +1,17 @@
+// Package cache keeps values by key for a time, in process or in redis, a cache is an
+// optimisation and its callers fall back to the source of the values when it fails
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+type Cache interface {
+	// Get returns the value of key and whether it was found
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set keeps value under key until ttl passes
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete drops keys, missing keys are ignored
+	Delete(ctx context.Context, keys ...string) error
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LRUTestSuite struct {
+	suite.Suite
+	now time.Time
+	lru *LRU
+}
+
+func (s *LRUTestSuite) SetupTest() {
+	s.now = time.Now()
+	s.lru = NewLRU(2)
+	s.lru.now = func() time.Time { return s.now }
+}
+
+func (s *LRUTestSuite) TestEviction() {
+	ctx := context.Background()
+	s.NoError(s.lru.Set(ctx, "first", []byte("1"), time.Minute))
+	s.NoError(s.lru.Set(ctx, "second", []byte("2"), time.Minute))
+
+	// reading first makes second the least recently used
+	value, ok, err := s.lru.Get(ctx, "first")
+	s.NoError(err)
+	s.True(ok)
+	s.Equal([]byte("1"), value)
+
+	s.NoError(s.lru.Set(ctx, "third", []byte("3"), time.Minute))
+	s.Equal(2, s.lru.Len())
+	_, ok, _ = s.lru.Get(ctx, "second")
+	s.False(ok)
+	_, ok, _ = s.lru.Get(ctx, "first")
+	s.True(ok)
+
+	s.NoError(s.lru.Delete(ctx, "first", "unknown"))
+	_, ok, _ = s.lru.Get(ctx, "first")
+	s.False(ok)
+	s.Equal(1, s.lru.Len())
+}
+
+func (s *LRUTestSuite) TestExpiry() {
+	ctx := context.Background()
+	s.NoError(s.lru.Set(ctx, "key", []byte("old"), time.Minute))
+	s.NoError(s.lru.Set(ctx, "key", []byte("new"), 2*time.Minute))
+
+	s.now = s.now.Add(time.Minute)
+	value, ok, _ := s.lru.Get(ctx, "key")
+	s.True(ok)
+	s.Equal([]byte("new"), value)
+
+	s.now = s.now.Add(time.Minute)
+	_, ok, _ = s.lru.Get(ctx, "key")
+	s.False(ok)
+	s.Zero(s.lru.Len())
+}
+
+func TestLRUTestSuite(t *testing.T) {
+	suite.Run(t, new(LRUTestSuite))
+}
@@ -1,99 +1,237 @@
 package config
 
 import (
-	"os"
-	"strings"
+	"io"
+	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
+// Config is loaded in layers: defaults, then the YAML file, then .env, then the environment.
+// Every field is bound to an environment variable through its env tag,
+// fields tagged secret are masked when the config is printed.
 type Config struct {
-	APP         string
-	Environment string
-	LogLevel    string
-	RPCPort     string
+	APP         string `yaml:"app" env:"APP"`
+	Environment string `yaml:"environment" env:"ENVIRONMENT"`
+	LogLevel    string `yaml:"log_level" env:"LOG_LEVEL"`
+	RPCPort     string `yaml:"rpc_port" env:"RPC_PORT"`
+
+	Log struct {
+		File               string        `yaml:"file" env:"LOG_FILE"`
+		MaxSizeMB          int           `yaml:"max_size_mb" env:"LOG_MAX_SIZE_MB"`
+		MaxBackups         int           `yaml:"max_backups" env:"LOG_MAX_BACKUPS"`
+		MaxAgeDays         int           `yaml:"max_age_days" env:"LOG_MAX_AGE_DAYS"`
+		Compress           bool          `yaml:"compress" env:"LOG_COMPRESS"`
+		RotateInterval     time.Duration `yaml:"rotate_interval" env:"LOG_ROTATE_INTERVAL"`
+		SamplingInitial    int           `yaml:"sampling_initial" env:"LOG_SAMPLING_INITIAL"`
+		SamplingThereafter int           `yaml:"sampling_thereafter" env:"LOG_SAMPLING_THEREAFTER"`
+	} `yaml:"log"`
 
 	Context struct {
-		Timeout string
-	}
+		Timeout time.Duration `yaml:"timeout" env:"CONTEXT_TIMEOUT"`
+	} `yaml:"context"`
 
 	DB struct {
-		Host     string
-		Port     string
-		Name     string
-		User     string
-		Password string
-		SslMode  string
-	}
+		Host     string `yaml:"host" env:"POSTGRES_HOST"`
+		Port     string `yaml:"port" env:"POSTGRES_PORT"`
+		Name     string `yaml:"name" env:"POSTGRES_DATABASE"`
+		User     string `yaml:"user" env:"POSTGRES_USER"`
+		Password string `yaml:"password" env:"POSTGRES_PASSWORD" secret:"true"`
+		SslMode  string `yaml:"ssl_mode" env:"POSTGRES_SSLMODE"`
+	} `yaml:"db"`
+
+	HealthCheck struct {
+		Interval time.Duration `yaml:"interval" env:"HEALTH_CHECK_INTERVAL"`
+		Timeout  time.Duration `yaml:"timeout" env:"HEALTH_CHECK_TIMEOUT"`
+	} `yaml:"health_check"`
+
+	Purge struct {
+		Interval  time.Duration `yaml:"interval" env:"PURGE_INTERVAL"`
+		Retention time.Duration `yaml:"retention" env:"PURGE_RETENTION"`
+	} `yaml:"purge"`
+
+	Tenure struct {
+		Interval time.Duration `yaml:"interval" env:"TENURE_INTERVAL"`
+	} `yaml:"tenure"`
+
+	// Duplicates searches live users for people registered twice, pairs scoring the threshold
+	// or more are listed for review
+	Duplicates struct {
+		Interval  time.Duration `yaml:"interval" env:"DUPLICATES_INTERVAL"`
+		Timeout   time.Duration `yaml:"timeout" env:"DUPLICATES_TIMEOUT"`
+		Threshold float64       `yaml:"threshold" env:"DUPLICATES_THRESHOLD"`
+	} `yaml:"duplicates"`
+
+	Authz struct {
+		PermissionCacheTTL time.Duration `yaml:"permission_cache_ttl" env:"AUTHZ_PERMISSION_CACHE_TTL"`
+	} `yaml:"authz"`
+
+	Invite struct {
+		TTL time.Duration `yaml:"ttl" env:"INVITE_TTL"`
+	} `yaml:"invite"`
+
+	EmailVerification struct {
+		TTL time.Duration `yaml:"ttl" env:"EMAIL_VERIFICATION_TTL"`
+	} `yaml:"email_verification"`
+
+	// Export bounds how long a streaming export of users or admins may run
+	Export struct {
+		Timeout time.Duration `yaml:"timeout" env:"EXPORT_TIMEOUT"`
+	} `yaml:"export"`
+
+	// Cache holds the cache of users and admins read by id, backend is lru (in process),
+	// redis or none
+	Cache struct {
+		Backend     string        `yaml:"backend" env:"CACHE_BACKEND"`
+		Size        int           `yaml:"size" env:"CACHE_SIZE"`
+		TTL         time.Duration `yaml:"ttl" env:"CACHE_TTL"`
+		NotFoundTTL time.Duration `yaml:"not_found_ttl" env:"CACHE_NOT_FOUND_TTL"`
+	} `yaml:"cache"`
+
+	Redis struct {
+		Address  string `yaml:"address" env:"REDIS_ADDRESS"`
+		Password string `yaml:"password" env:"REDIS_PASSWORD" secret:"true"`
+		DB       int    `yaml:"db" env:"REDIS_DB"`
+	} `yaml:"redis"`
+
+	// Medical holds the key encryption key of medical profiles, base64 of 32 bytes,
+	// medical profiles are unavailable while it is empty
+	Medical struct {
+		KeyId string `yaml:"key_id" env:"MEDICAL_KEY_ID"`
+		Key   string `yaml:"key" env:"MEDICAL_KEY" secret:"true"`
+	} `yaml:"medical"`
+
+	NotificationService struct {
+		Host string `yaml:"host" env:"NOTIFICATION_SERVICE_HOST"`
+		Port string `yaml:"port" env:"NOTIFICATION_SERVICE_PORT"`
+	} `yaml:"notification_service"`
+
+	Metrics struct {
+		Port string `yaml:"port" env:"METRICS_PORT"`
+	} `yaml:"metrics"`
 
 	OTLPCollector struct {
-		Host string
-		Port string
-	}
-
-	MongoDb struct {
-		MongoURI      string
-		MongoDatabase string
-	}
+		Host        string  `yaml:"host" env:"OTLP_COLLECTOR_HOST"`
+		Port        string  `yaml:"port" env:"OTLP_COLLECTOR_PORT"`
+		Exporter    string  `yaml:"exporter" env:"OTLP_EXPORTER"`
+		Insecure    bool    `yaml:"insecure" env:"OTLP_INSECURE"`
+		CACert      string  `yaml:"ca_cert" env:"OTLP_CA_CERT"`
+		SampleRatio float64 `yaml:"sample_ratio" env:"OTLP_SAMPLE_RATIO"`
+	} `yaml:"otlp_collector"`
 
 	Kafka struct {
-		Address []string
+		Address []string `yaml:"address" env:"KAFKA_ADDRESS"`
 		Topic   struct {
-			InvestorCreate string
-		}
-	}
-
-	PostService struct {
-		PostServiceHost string
-		PostServicePort string
-	}
-
-	CommentService struct {
-		CommentServiceHost string
-		CommentServicePort string
-	}
+			InvestorCreate   string `yaml:"investor_create" env:"KAFKA_TOPIC_INVESTOR_CREATE"`
+			UserErased       string `yaml:"user_erased" env:"KAFKA_TOPIC_USER_ERASED"`
+			ConsentWithdrawn string `yaml:"consent_withdrawn" env:"KAFKA_TOPIC_CONSENT_WITHDRAWN"`
+			CacheInvalidated string `yaml:"cache_invalidated" env:"KAFKA_TOPIC_CACHE_INVALIDATED"`
+			UserMerged       string `yaml:"user_merged" env:"KAFKA_TOPIC_USER_MERGED"`
+		} `yaml:"topic"`
+	} `yaml:"kafka"`
 }
 
-func New() *Config {
+func defaults() *Config {
 	var c Config
 
 	// general configuration
-	c.APP = getEnv("APP", "app")
-	c.Environment = getEnv("ENVIRONMENT", "develop")
-	c.LogLevel = getEnv("LOG_LEVEL", "debug")
-	c.RPCPort = getEnv("RPC_PORT", ":50025")
-	c.Context.Timeout = getEnv("CONTEXT_TIMEOUT", "30s")
+	c.APP = "app"
+	c.Environment = "develop"
+	c.LogLevel = "debug"
+	c.RPCPort = ":50025"
+	c.Context.Timeout = 30 * time.Second
+
+	// log configuration
+	c.Log.MaxSizeMB = 100
+	c.Log.MaxBackups = 7
+	c.Log.MaxAgeDays = 28
+	c.Log.Compress = true
+	c.Log.RotateInterval = 24 * time.Hour
+	c.Log.SamplingInitial = 100
+	c.Log.SamplingThereafter = 100
 
 	// db configuration
-	c.DB.Host = getEnv("POSTGRES_HOST", "localhost")
-	c.DB.Port = getEnv("POSTGRES_PORT", "5544")
-	c.DB.User = getEnv("POSTGRES_USER", "postgres")
-	c.DB.Password = getEnv("POSTGRES_PASSWORD", "1234")
-	c.DB.SslMode = getEnv("POSTGRES_SSLMODE", "disable")
-	c.DB.Name = getEnv("POSTGRES_DATABASE", "dennic_user_service")
+	c.DB.Host = "localhost"
+	c.DB.Port = "5544"
+	c.DB.User = "postgres"
+	c.DB.Password = "1234"
+	c.DB.SslMode = "disable"
+	c.DB.Name = "dennic_user_service"
 
-	// otlp collector configuration
-	c.OTLPCollector.Host = getEnv("OTLP_COLLECTOR_HOST", "localhost")
-	c.OTLPCollector.Port = getEnv("OTLP_COLLECTOR_PORT", ":2020")
+	// health check configuration
+	c.HealthCheck.Interval = 10 * time.Second
+	c.HealthCheck.Timeout = 3 * time.Second
 
-	// kafka configuration
-	c.Kafka.Address = strings.Split(getEnv("KAFKA_ADDRESS", "localhost:29092"), ",")
-	c.Kafka.Topic.InvestorCreate = getEnv("KAFKA_TOPIC_INVESTOR_CREATE", "investor.created")
+	// purge configuration, accounts soft-deleted longer than the retention are erased
+	c.Purge.Interval = time.Hour
+	c.Purge.Retention = 30 * 24 * time.Hour
 
-	c.MongoDb.MongoURI = getEnv("MONGO_URI", "mongodb://localhost:27018")
-	c.MongoDb.MongoDatabase = getEnv("MONGO_DATABASE", "userdb")
+	// tenure configuration, work years of admins are recomputed every interval
+	c.Tenure.Interval = 24 * time.Hour
 
-	c.PostService.PostServiceHost = getEnv("POST_SERVICE_HOST", "localhost")
-	c.PostService.PostServicePort = getEnv("POST_SERVICE_PORT", "3030")
+	// duplicates configuration, the search compares the names of users born on the same day
+	c.Duplicates.Interval = 24 * time.Hour
+	c.Duplicates.Timeout = 30 * time.Minute
+	c.Duplicates.Threshold = 0.9
 
-	c.CommentService.CommentServiceHost = getEnv("COMMENT_SERVICE_HOST", "localhost")
-	c.CommentService.CommentServicePort = getEnv("COMMENT_SERVICE_PORT", "4040")
+	// authorization configuration, role changes reach other instances after the cache ttl
+	c.Authz.PermissionCacheTTL = time.Minute
+
+	// invite configuration, admin invites can be accepted until the ttl passes
+	c.Invite.TTL = 72 * time.Hour
+
+	// email verification configuration, a verification link is valid for the ttl
+	c.EmailVerification.TTL = 24 * time.Hour
+
+	// export configuration, exports read whole tables and outlive the context timeout
+	c.Export.Timeout = 10 * time.Minute
+
+	// cache configuration, a write on one replica reaches the others through kafka and the
+	// ttl bounds what is missed
+	c.Cache.Backend = "lru"
+	c.Cache.Size = 10000
+	c.Cache.TTL = 5 * time.Minute
+	c.Cache.NotFoundTTL = 30 * time.Second
+
+	// redis configuration
+	c.Redis.Address = "localhost:6379"
+
+	// medical configuration, the key id is stored with every data key it seals
+	c.Medical.KeyId = "v1"
+
+	// metrics configuration
+	c.Metrics.Port = ":9090"
+
+	// otlp collector configuration
+	c.OTLPCollector.Host = "localhost"
+	c.OTLPCollector.Port = ":2020"
+	c.OTLPCollector.Exporter = "grpc"
+	c.OTLPCollector.Insecure = true
+	c.OTLPCollector.SampleRatio = 1.0
+
+	// kafka configuration
+	c.Kafka.Address = []string{"localhost:29092"}
+	c.Kafka.Topic.InvestorCreate = "investor.created"
+	c.Kafka.Topic.UserErased = "user.erased"
+	c.Kafka.Topic.ConsentWithdrawn = "user.consent_withdrawn"
+	c.Kafka.Topic.CacheInvalidated = "user.cache_invalidated"
+	c.Kafka.Topic.UserMerged = "user.merged"
 
 	return &c
 }
 
-func getEnv(key string, defaultVaule string) string {
-	value, exists := os.LookupEnv(key)
-	if exists {
-		return value
+// New loads the configuration from CONFIG_FILE (default config.yaml), .env and the environment,
+// and returns every invalid value at once
+func New() (*Config, error) {
+	return Load(getEnv("CONFIG_FILE", defaultConfigFile), defaultDotEnvFile)
+}
+
+// Print writes the configuration as YAML with secrets masked
+func (c *Config) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c.Redacted()); err != nil {
+		return err
 	}
-	return defaultVaule
+	return encoder.Close()
 }
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (s *ConfigTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *ConfigTestSuite) write(name, content string) string {
+	path := filepath.Join(s.dir, name)
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func (s *ConfigTestSuite) TestLayers() {
+	configFile := s.write("config.yaml", `
+app: from_yaml
+rpc_port: ":7000"
+context:
+  timeout: 5s
+db:
+  host: yaml-host
+kafka:
+  address: ["yaml:9092"]
+`)
+	dotEnvFile := s.write(".env", "RPC_PORT=:8000\nPOSTGRES_PASSWORD=from_dotenv\n")
+	s.T().Setenv("RPC_PORT", ":9000")
+	s.T().Setenv("KAFKA_ADDRESS", "a:9092, b:9092")
+
+	cfg, err := Load(configFile, dotEnvFile)
+	s.Require().NoError(err)
+
+	// yaml over defaults
+	s.Equal("from_yaml", cfg.APP)
+	s.Equal(5*time.Second, cfg.Context.Timeout)
+	s.Equal("yaml-host", cfg.DB.Host)
+	// .env over yaml
+	s.Equal("from_dotenv", cfg.DB.Password)
+	// environment over .env
+	s.Equal(":9000", cfg.RPCPort)
+	s.Equal([]string{"a:9092", "b:9092"}, cfg.Kafka.Address)
+	// untouched defaults
+	s.Equal("dennic_user_service", cfg.DB.Name)
+}
+
+func (s *ConfigTestSuite) TestMissingFilesUseDefaults() {
+	cfg, err := Load(filepath.Join(s.dir, "missing.yaml"), filepath.Join(s.dir, "missing.env"))
+	s.Require().NoError(err)
+	s.Equal(30*time.Second, cfg.Context.Timeout)
+}
+
+func (s *ConfigTestSuite) TestAggregatedErrors() {
+	s.T().Setenv("CONTEXT_TIMEOUT", "soon")
+	s.T().Setenv("OTLP_INSECURE", "maybe")
+
+	_, err := Load("", "")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "CONTEXT_TIMEOUT")
+	s.Contains(err.Error(), "OTLP_INSECURE")
+}
+
+func (s *ConfigTestSuite) TestValidate() {
+	s.T().Setenv("APP", "")
+	s.T().Setenv("OTLP_SAMPLE_RATIO", "2")
+	s.T().Setenv("LOG_LEVEL", "loud")
+	s.T().Setenv("MEDICAL_KEY", "c2hvcnQ=")
+	s.T().Setenv("CACHE_BACKEND", "memcached")
+	s.T().Setenv("DUPLICATES_THRESHOLD", "1.5")
+
+	_, err := Load("", "")
+	s.Require().Error(err)
+	s.Contains(err.Error(), "APP is required")
+	s.Contains(err.Error(), "OTLP_SAMPLE_RATIO")
+	s.Contains(err.Error(), "LOG_LEVEL")
+	s.Contains(err.Error(), "MEDICAL_KEY")
+	s.Contains(err.Error(), "CACHE_BACKEND")
+	s.Contains(err.Error(), "DUPLICATES_THRESHOLD")
+}
+
+func (s *ConfigTestSuite) TestPrintRedactsSecrets() {
+	s.T().Setenv("POSTGRES_PASSWORD", "s3cret")
+
+	cfg, err := Load("", "")
+	s.Require().NoError(err)
+
+	var out bytes.Buffer
+	s.Require().NoError(cfg.Print(&out))
+	s.NotContains(out.String(), "s3cret")
+	s.Contains(out.String(), redactedValue)
+	s.Equal("s3cret", cfg.DB.Password)
+}
+
+func TestConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
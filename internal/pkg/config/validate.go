@@ -0,0 +1,116 @@
+package config
+
+import (
+	"dennic_user_service/internal/pkg/envelope"
+	"errors"
+	"fmt"
+)
+
+var (
+	logLevels = map[string]bool{
+		"debug": true, "info": true, "warn": true, "error": true, "dpanic": true, "panic": true, "fatal": true,
+	}
+	cacheBackends = map[string]bool{
+		"lru": true, "redis": true, "none": true,
+	}
+	otlpExporters = map[string]bool{
+		"grpc": true, "http": true, "stdout": true, "none": true,
+	}
+)
+
+// Validate reports every missing or out of range value, not just the first one
+func (c *Config) Validate() error {
+	var errs []error
+
+	for _, field := range []struct{ key, value string }{
+		{"APP", c.APP},
+		{"ENVIRONMENT", c.Environment},
+		{"RPC_PORT", c.RPCPort},
+		{"POSTGRES_HOST", c.DB.Host},
+		{"POSTGRES_PORT", c.DB.Port},
+		{"POSTGRES_DATABASE", c.DB.Name},
+		{"POSTGRES_USER", c.DB.User},
+		{"METRICS_PORT", c.Metrics.Port},
+	} {
+		if field.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", field.key))
+		}
+	}
+
+	if !logLevels[c.LogLevel] {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL %q is not a valid level", c.LogLevel))
+	}
+	if c.Log.MaxSizeMB <= 0 {
+		errs = append(errs, errors.New("LOG_MAX_SIZE_MB must be positive"))
+	}
+	if c.Log.MaxBackups < 0 || c.Log.MaxAgeDays < 0 || c.Log.RotateInterval < 0 {
+		errs = append(errs, errors.New("LOG_MAX_BACKUPS, LOG_MAX_AGE_DAYS and LOG_ROTATE_INTERVAL must not be negative"))
+	}
+	if c.Context.Timeout <= 0 {
+		errs = append(errs, errors.New("CONTEXT_TIMEOUT must be positive"))
+	}
+	if c.HealthCheck.Interval <= 0 {
+		errs = append(errs, errors.New("HEALTH_CHECK_INTERVAL must be positive"))
+	}
+	if c.HealthCheck.Timeout <= 0 {
+		errs = append(errs, errors.New("HEALTH_CHECK_TIMEOUT must be positive"))
+	}
+	if c.Purge.Interval < 0 {
+		errs = append(errs, errors.New("PURGE_INTERVAL must not be negative"))
+	}
+	if c.Purge.Interval > 0 && c.Purge.Retention <= 0 {
+		errs = append(errs, errors.New("PURGE_RETENTION must be positive when purging is enabled"))
+	}
+	if c.Tenure.Interval < 0 {
+		errs = append(errs, errors.New("TENURE_INTERVAL must not be negative"))
+	}
+	if c.Duplicates.Interval < 0 {
+		errs = append(errs, errors.New("DUPLICATES_INTERVAL must not be negative"))
+	}
+	if c.Duplicates.Interval > 0 && c.Duplicates.Timeout <= 0 {
+		errs = append(errs, errors.New("DUPLICATES_TIMEOUT must be positive when the duplicate search is enabled"))
+	}
+	if c.Duplicates.Threshold <= 0 || c.Duplicates.Threshold > 1 {
+		errs = append(errs, fmt.Errorf("DUPLICATES_THRESHOLD %v must be above 0 and at most 1", c.Duplicates.Threshold))
+	}
+	if c.Authz.PermissionCacheTTL < 0 {
+		errs = append(errs, errors.New("AUTHZ_PERMISSION_CACHE_TTL must not be negative"))
+	}
+	if c.Invite.TTL <= 0 {
+		errs = append(errs, errors.New("INVITE_TTL must be positive"))
+	}
+	if c.EmailVerification.TTL <= 0 {
+		errs = append(errs, errors.New("EMAIL_VERIFICATION_TTL must be positive"))
+	}
+	if !cacheBackends[c.Cache.Backend] {
+		errs = append(errs, fmt.Errorf("CACHE_BACKEND %q must be one of lru, redis, none", c.Cache.Backend))
+	}
+	if c.Cache.Backend != "none" && (c.Cache.TTL <= 0 || c.Cache.NotFoundTTL < 0) {
+		errs = append(errs, errors.New("CACHE_TTL must be positive and CACHE_NOT_FOUND_TTL must not be negative"))
+	}
+	if c.Cache.Backend == "lru" && c.Cache.Size <= 0 {
+		errs = append(errs, errors.New("CACHE_SIZE must be positive"))
+	}
+	if c.Cache.Backend == "redis" && c.Redis.Address == "" {
+		errs = append(errs, errors.New("REDIS_ADDRESS is required when CACHE_BACKEND is redis"))
+	}
+	if c.Medical.Key != "" {
+		if _, err := envelope.Parse(c.Medical.KeyId, c.Medical.Key); err != nil {
+			errs = append(errs, fmt.Errorf("MEDICAL_KEY_ID and MEDICAL_KEY are not a valid key: %w", err))
+		}
+	}
+	if !otlpExporters[c.OTLPCollector.Exporter] {
+		errs = append(errs, fmt.Errorf("OTLP_EXPORTER %q must be one of grpc, http, stdout, none", c.OTLPCollector.Exporter))
+	}
+	if c.OTLPCollector.SampleRatio < 0 || c.OTLPCollector.SampleRatio > 1 {
+		errs = append(errs, errors.New("OTLP_SAMPLE_RATIO must be between 0 and 1"))
+	}
+	if len(c.Kafka.Address) == 0 {
+		errs = append(errs, errors.New("KAFKA_ADDRESS is required"))
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package envelope encrypts records with their own data key, the data key is stored next to the
+// record sealed with a key encryption key from the configuration, so the database alone never
+// holds what it takes to read the records
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the size of key encryption keys and data keys, AES-256
+const KeySize = 32
+
+var (
+	ErrUnknownKey = errors.New("envelope: data key sealed with an unknown key")
+	ErrMalformed  = errors.New("envelope: ciphertext is malformed")
+)
+
+// Keyring seals data keys with the key encryption key named KeyId
+type Keyring struct {
+	keyId string
+	kek   cipher.AEAD
+}
+
+// New returns a keyring for a KeySize bytes key encryption key
+func New(keyId string, key []byte) (*Keyring, error) {
+	if keyId == "" {
+		return nil, errors.New("envelope: key id is required")
+	}
+	kek, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Keyring{keyId: keyId, kek: kek}, nil
+}
+
+// Parse returns a keyring for a base64 encoded key encryption key
+func Parse(keyId, encodedKey string) (*Keyring, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("envelope: key is not base64: %w", err)
+	}
+	return New(keyId, key)
+}
+
+// KeyId names the key encryption key, it is stored with each sealed data key
+func (k *Keyring) KeyId() string {
+	return k.keyId
+}
+
+// NewDataKey returns a random data key and the same key sealed for storage
+func (k *Keyring) NewDataKey() (DataKey, []byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return DataKey{}, nil, err
+	}
+	dataKey, err := newAEAD(key)
+	if err != nil {
+		return DataKey{}, nil, err
+	}
+	sealed, err := seal(k.kek, key, []byte(k.keyId))
+	if err != nil {
+		return DataKey{}, nil, err
+	}
+	return DataKey{aead: dataKey}, sealed, nil
+}
+
+// OpenDataKey opens a data key sealed by NewDataKey with the key encryption key keyId
+func (k *Keyring) OpenDataKey(keyId string, sealed []byte) (DataKey, error) {
+	if keyId != k.keyId {
+		return DataKey{}, ErrUnknownKey
+	}
+	key, err := open(k.kek, sealed, []byte(keyId))
+	if err != nil {
+		return DataKey{}, err
+	}
+	dataKey, err := newAEAD(key)
+	if err != nil {
+		return DataKey{}, err
+	}
+	return DataKey{aead: dataKey}, nil
+}
+
+// DataKey encrypts the values of one record
+type DataKey struct {
+	aead cipher.AEAD
+}
+
+// Seal encrypts plaintext, aad binds the ciphertext to its place, e.g. the record id and the column,
+// so it can not be moved to another one
+func (d DataKey) Seal(plaintext, aad []byte) ([]byte, error) {
+	return seal(d.aead, plaintext, aad)
+}
+
+// Open decrypts a ciphertext of Seal with the same aad
+func (d DataKey) Open(ciphertext, aad []byte) ([]byte, error) {
+	return open(d.aead, ciphertext, aad)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("envelope: key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal prepends the random nonce to the ciphertext
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, aad []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformed
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
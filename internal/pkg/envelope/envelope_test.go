@@ -0,0 +1,69 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EnvelopeTestSuite struct {
+	suite.Suite
+	keyring *Keyring
+}
+
+func (s *EnvelopeTestSuite) SetupTest() {
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	s.Require().NoError(err)
+
+	s.keyring, err = Parse("v1", base64.StdEncoding.EncodeToString(key))
+	s.Require().NoError(err)
+}
+
+func (s *EnvelopeTestSuite) TestSealAndOpen() {
+	dataKey, sealedKey, err := s.keyring.NewDataKey()
+	s.Require().NoError(err)
+
+	ciphertext, err := dataKey.Seal([]byte("penicillin"), []byte("user:allergies"))
+	s.Require().NoError(err)
+	s.False(bytes.Contains(ciphertext, []byte("penicillin")))
+
+	opened, err := s.keyring.OpenDataKey("v1", sealedKey)
+	s.Require().NoError(err)
+	plaintext, err := opened.Open(ciphertext, []byte("user:allergies"))
+	s.Require().NoError(err)
+	s.Equal("penicillin", string(plaintext))
+
+	// a ciphertext moved to another column or record does not open
+	_, err = opened.Open(ciphertext, []byte("user:medications"))
+	s.Error(err)
+	_, err = opened.Open(ciphertext[:4], []byte("user:allergies"))
+	s.ErrorIs(err, ErrMalformed)
+}
+
+func (s *EnvelopeTestSuite) TestKeys() {
+	_, sealedKey, err := s.keyring.NewDataKey()
+	s.Require().NoError(err)
+
+	_, err = s.keyring.OpenDataKey("v0", sealedKey)
+	s.ErrorIs(err, ErrUnknownKey)
+
+	other, err := New("v1", make([]byte, KeySize))
+	s.Require().NoError(err)
+	_, err = other.OpenDataKey("v1", sealedKey)
+	s.Error(err)
+
+	_, err = New("v1", []byte("short"))
+	s.Error(err)
+	_, err = Parse("v1", "not base64!")
+	s.Error(err)
+	_, err = New("", make([]byte, KeySize))
+	s.Error(err)
+}
+
+func TestEnvelopeTestSuite(t *testing.T) {
+	suite.Run(t, new(EnvelopeTestSuite))
+}
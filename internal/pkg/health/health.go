@@ -0,0 +1,136 @@
+// Package health provides grpc.health.v1 serving status driven by dependency checks
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	UserServiceName  = "user.UserService"
+	AdminServiceName = "user.AdminService"
+)
+
+// Pinger is satisfied by *pgxpool.Pool and therefore by *postgres.PostgresDB
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type Checker struct {
+	server   *health.Server
+	logger   *zap.Logger
+	db       Pinger
+	brokers  []string
+	interval time.Duration
+	timeout  time.Duration
+	stop     chan struct{}
+	once     sync.Once
+}
+
+func New(logger *zap.Logger, db Pinger, brokers []string, interval, timeout time.Duration) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		logger:   logger,
+		db:       db,
+		brokers:  brokers,
+		interval: interval,
+		timeout:  timeout,
+		stop:     make(chan struct{}),
+	}
+
+	// nothing is serving until the first check succeeds
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return c
+}
+
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Run checks dependencies every interval until Shutdown is called
+func (c *Checker) Run() {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.check()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.check()
+		}
+	}
+}
+
+// Shutdown flips every service to NOT_SERVING and ignores further updates
+func (c *Checker) Shutdown() {
+	c.once.Do(func() {
+		close(c.stop)
+		c.server.Shutdown()
+	})
+}
+
+func (c *Checker) check() {
+	var (
+		dbStatus    = healthpb.HealthCheckResponse_SERVING
+		kafkaStatus = healthpb.HealthCheckResponse_SERVING
+	)
+
+	if err := c.pingDB(); err != nil {
+		c.logger.Warn("health check postgres", zap.Error(err))
+		dbStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	if err := c.pingKafka(); err != nil {
+		c.logger.Warn("health check kafka", zap.Error(err))
+		kafkaStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	c.setStatus(dbStatus, kafkaStatus)
+}
+
+// setStatus derives the status of every service from its dependencies, the user service needs
+// both, the admin service and the overall status only postgres, so a kafka outage does not take
+// the whole server out of rotation
+func (c *Checker) setStatus(dbStatus, kafkaStatus healthpb.HealthCheckResponse_ServingStatus) {
+	userStatus := dbStatus
+	if kafkaStatus != healthpb.HealthCheckResponse_SERVING {
+		userStatus = kafkaStatus
+	}
+
+	c.server.SetServingStatus(UserServiceName, userStatus)
+	c.server.SetServingStatus(AdminServiceName, dbStatus)
+	c.server.SetServingStatus("", dbStatus)
+}
+
+func (c *Checker) pingDB() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.db.Ping(ctx)
+}
+
+// pingKafka succeeds when at least one of the configured brokers accepts a connection
+func (c *Checker) pingKafka() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var err error
+	for _, broker := range c.brokers {
+		var conn *kafka.Conn
+		conn, err = kafka.DialContext(ctx, "tcp", broker)
+		if err == nil {
+			return conn.Close()
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,128 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type HealthTestSuite struct {
+	suite.Suite
+	broker net.Listener
+}
+
+// pingerStub fails every ping while err is set
+type pingerStub struct {
+	err error
+}
+
+func (p *pingerStub) Ping(context.Context) error {
+	return p.err
+}
+
+// SetupTest listens where a broker would, kafka-go only dials until the first request
+func (s *HealthTestSuite) SetupTest() {
+	broker, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	s.broker = broker
+}
+
+func (s *HealthTestSuite) TearDownTest() {
+	_ = s.broker.Close()
+}
+
+// unreachable returns an address nothing listens on
+func (s *HealthTestSuite) unreachable() string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	address := listener.Addr().String()
+	s.Require().NoError(listener.Close())
+	return address
+}
+
+func (s *HealthTestSuite) status(checker *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := checker.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	s.Require().NoError(err)
+	return resp.Status
+}
+
+func (s *HealthTestSuite) TestCheck() {
+	const (
+		serving    = healthpb.HealthCheckResponse_SERVING
+		notServing = healthpb.HealthCheckResponse_NOT_SERVING
+	)
+	cases := []struct {
+		name    string
+		dbErr   error
+		kafkaUp bool
+		user    healthpb.HealthCheckResponse_ServingStatus
+		admin   healthpb.HealthCheckResponse_ServingStatus
+		overall healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{name: "all up", kafkaUp: true, user: serving, admin: serving, overall: serving},
+		{name: "kafka down", user: notServing, admin: serving, overall: serving},
+		{name: "postgres down", dbErr: errors.New("connection refused"), kafkaUp: true, user: notServing, admin: notServing, overall: notServing},
+		{name: "all down", dbErr: errors.New("connection refused"), user: notServing, admin: notServing, overall: notServing},
+	}
+	for _, c := range cases {
+		s.Run(c.name, func() {
+			broker := s.unreachable()
+			if c.kafkaUp {
+				broker = s.broker.Addr().String()
+			}
+			checker := New(zap.NewNop(), &pingerStub{err: c.dbErr}, []string{broker}, time.Minute, time.Second)
+			s.Equal(notServing, s.status(checker, ""))
+
+			checker.check()
+			s.Equal(c.user, s.status(checker, UserServiceName))
+			s.Equal(c.admin, s.status(checker, AdminServiceName))
+			s.Equal(c.overall, s.status(checker, ""))
+		})
+	}
+}
+
+// a database that comes back brings every service back on the next check
+func (s *HealthTestSuite) TestRecovery() {
+	db := &pingerStub{err: errors.New("connection refused")}
+	checker := New(zap.NewNop(), db, []string{s.broker.Addr().String()}, time.Minute, time.Second)
+
+	checker.check()
+	s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.status(checker, ""))
+
+	db.err = nil
+	checker.check()
+	for _, service := range []string{"", UserServiceName, AdminServiceName} {
+		s.Equal(healthpb.HealthCheckResponse_SERVING, s.status(checker, service), service)
+	}
+}
+
+// Shutdown flips every service to NOT_SERVING, a check running after it changes nothing
+func (s *HealthTestSuite) TestShutdown() {
+	checker := New(zap.NewNop(), &pingerStub{}, []string{s.broker.Addr().String()}, time.Millisecond, time.Second)
+	done := make(chan struct{})
+	go func() {
+		checker.Run()
+		close(done)
+	}()
+	s.Eventually(func() bool {
+		return s.status(checker, "") == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, time.Millisecond)
+
+	checker.Shutdown()
+	<-done
+	checker.Shutdown()
+	checker.check()
+	for _, service := range []string{"", UserServiceName, AdminServiceName} {
+		s.Equal(healthpb.HealthCheckResponse_NOT_SERVING, s.status(checker, service), service)
+	}
+}
+
+func TestHealthTestSuite(t *testing.T) {
+	suite.Run(t, new(HealthTestSuite))
+}
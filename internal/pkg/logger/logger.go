@@ -1,57 +1,103 @@
 package logger
 
 import (
+	"context"
 	"dennic_user_service/internal/pkg/app"
+	"dennic_user_service/internal/pkg/config"
+	"os"
 	"path/filepath"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
-func productionConfig(file string) zap.Config {
-	configZap := zap.NewProductionConfig()
-	configZap.OutputPaths = []string{"stdout", file}
-	configZap.DisableStacktrace = true
-	return configZap
+const (
+	TraceIDKey = "trace_id"
+	SpanIDKey  = "span_id"
+)
+
+func productionConfig() zapcore.EncoderConfig {
+	return zap.NewProductionEncoderConfig()
+}
+
+func developmentConfig() zapcore.EncoderConfig {
+	return zap.NewDevelopmentEncoderConfig()
 }
 
-func developmentConfig(file string) zap.Config {
-	configZap := zap.NewDevelopmentConfig()
-	configZap.OutputPaths = []string{"stdout", file}
-	configZap.ErrorOutputPaths = []string{"stderr"}
-	return configZap
+// New builds the service logger writing to stdout and a rotated file.
+// The returned level can be changed at runtime and is picked up by every logger derived from it.
+func New(cfg *config.Config) (*zap.Logger, zap.AtomicLevel, error) {
+	level, err := zap.ParseAtomicLevel(cfg.LogLevel)
+	if err != nil {
+		level = zap.NewAtomicLevelAt(zap.DebugLevel)
+	}
+
+	file := cfg.Log.File
+	if file == "" {
+		file = cfg.APP + ".log"
+	}
+
+	rotator := &lumberjack.Logger{
+		Filename:   filepath.Join("./" + file),
+		MaxSize:    cfg.Log.MaxSizeMB,
+		MaxBackups: cfg.Log.MaxBackups,
+		MaxAge:     cfg.Log.MaxAgeDays,
+		Compress:   cfg.Log.Compress,
+	}
+	if cfg.Log.RotateInterval > 0 {
+		go rotateEvery(rotator, cfg.Log.RotateInterval)
+	}
+
+	var (
+		encoder zapcore.Encoder
+		options = []zap.Option{zap.AddCaller(), zap.ErrorOutput(zapcore.Lock(os.Stderr))}
+	)
+	if cfg.Environment == app.EnvironmentDevelop {
+		encoder = zapcore.NewConsoleEncoder(developmentConfig())
+		options = append(options, zap.Development(), zap.AddStacktrace(zap.WarnLevel))
+	} else {
+		encoder = zapcore.NewJSONEncoder(productionConfig())
+	}
+
+	core := newRedactCore(zapcore.NewCore(
+		encoder,
+		zapcore.NewMultiWriteSyncer(zapcore.Lock(os.Stdout), zapcore.AddSync(rotator)),
+		level,
+	))
+
+	// keep the first N entries with the same message per second, then every Mth
+	if cfg.Log.SamplingInitial > 0 {
+		core = zapcore.NewSamplerWithOptions(core, time.Second, cfg.Log.SamplingInitial, cfg.Log.SamplingThereafter)
+	}
+
+	return zap.New(core, options...), level, nil
 }
 
-func New(level, environment string, file_name string) (*zap.Logger, error) {
-	file := filepath.Join("./" + file_name)
-
-	configZap := productionConfig(file)
-
-	if environment == app.EnvironmentDevelop {
-		configZap = developmentConfig(file)
-	}
-
-	switch level {
-	case "debug":
-		configZap.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
-	case "info":
-		configZap.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
-	case "warn":
-		configZap.Level = zap.NewAtomicLevelAt(zap.WarnLevel)
-	case "error":
-		configZap.Level = zap.NewAtomicLevelAt(zap.ErrorLevel)
-	case "dpanic":
-		configZap.Level = zap.NewAtomicLevelAt(zap.DPanicLevel)
-	case "panic":
-		configZap.Level = zap.NewAtomicLevelAt(zap.PanicLevel)
-	case "fatal":
-		configZap.Level = zap.NewAtomicLevelAt(zap.FatalLevel)
-	default:
-		configZap.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
-	}
-	return configZap.Build()
+func rotateEvery(rotator *lumberjack.Logger, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		_ = rotator.Rotate()
+	}
 }
 
 func Error(err error) zapcore.Field {
 	return zap.Error(err)
 }
+
+// WithTrace returns a logger annotated with the trace_id and span_id of the span in ctx
+func WithTrace(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return logger
+	}
+
+	return logger.With(
+		zap.String(TraceIDKey, spanContext.TraceID().String()),
+		zap.String(SpanIDKey, spanContext.SpanID().String()),
+	)
+}
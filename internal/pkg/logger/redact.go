@@ -0,0 +1,92 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const redactedValue = "******"
+
+var (
+	secretKeys = []string{"password", "token", "secret"}
+	phoneKeys  = []string{"phone"}
+)
+
+// redactCore masks sensitive string fields before they reach the encoder
+type redactCore struct {
+	zapcore.Core
+}
+
+func newRedactCore(core zapcore.Core) zapcore.Core {
+	return &redactCore{Core: core}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(Redact(fields))}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, Redact(fields))
+}
+
+// Redact returns fields with passwords and tokens masked and phone numbers reduced to their last digits
+func Redact(fields []zapcore.Field) []zapcore.Field {
+	var redacted []zapcore.Field
+	for i, field := range fields {
+		if field.Type != zapcore.StringType && field.Type != zapcore.ByteStringType {
+			continue
+		}
+
+		masked, ok := mask(field)
+		if !ok {
+			continue
+		}
+		if redacted == nil {
+			redacted = append([]zapcore.Field(nil), fields...)
+		}
+		redacted[i] = masked
+	}
+
+	if redacted == nil {
+		return fields
+	}
+	return redacted
+}
+
+func mask(field zapcore.Field) (zapcore.Field, bool) {
+	key := strings.ToLower(field.Key)
+
+	for _, secret := range secretKeys {
+		if strings.Contains(key, secret) {
+			return zapcore.Field{Key: field.Key, Type: zapcore.StringType, String: redactedValue}, true
+		}
+	}
+
+	for _, phone := range phoneKeys {
+		if strings.Contains(key, phone) {
+			value := field.String
+			if field.Type == zapcore.ByteStringType {
+				value = string(field.Interface.([]byte))
+			}
+			return zapcore.Field{Key: field.Key, Type: zapcore.StringType, String: MaskPhone(value)}, true
+		}
+	}
+
+	return field, false
+}
+
+// MaskPhone keeps the last four characters of a phone number
+func MaskPhone(phone string) string {
+	if len(phone) <= 4 {
+		return strings.Repeat("*", len(phone))
+	}
+	return strings.Repeat("*", len(phone)-4) + phone[len(phone)-4:]
+}
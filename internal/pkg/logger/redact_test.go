@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type RedactTestSuite struct {
+	suite.Suite
+}
+
+func (s *RedactTestSuite) TestRedactCore() {
+	core, logs := observer.New(zap.DebugLevel)
+	logger := zap.New(newRedactCore(core)).With(zap.String("refresh_token", "abc.def"))
+
+	logger.Info("user created",
+		zap.String("phone_number", "+998994767316"),
+		zap.String("password", "secret"),
+		zap.String("first_name", "John"),
+		zap.Int("user_order", 7),
+	)
+
+	s.Require().Equal(1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	s.Equal("*********7316", fields["phone_number"])
+	s.Equal(redactedValue, fields["password"])
+	s.Equal(redactedValue, fields["refresh_token"])
+	s.Equal("John", fields["first_name"])
+	s.Equal(int64(7), fields["user_order"])
+}
+
+func (s *RedactTestSuite) TestRedactLeavesInputUntouched() {
+	fields := []zapcore.Field{zap.String("password", "secret")}
+
+	redacted := Redact(fields)
+	s.Equal(redactedValue, redacted[0].String)
+	s.Equal("secret", fields[0].String)
+}
+
+func (s *RedactTestSuite) TestMaskPhone() {
+	s.Equal("****", MaskPhone("1234"))
+	s.Equal("***4567", MaskPhone("1234567"))
+}
+
+func TestRedactTestSuite(t *testing.T) {
+	suite.Run(t, new(RedactTestSuite))
+}
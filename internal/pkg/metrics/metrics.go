@@ -0,0 +1,113 @@
+// Package metrics provides prometheus collectors exposed on the /metrics endpoint
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "dennic_user_service"
+
+var (
+	Registry = prometheus.NewRegistry()
+	factory  = promauto.With(Registry)
+)
+
+// grpc metrics
+var (
+	GRPCHandled = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "server_handled_total",
+		Help:      "Total number of RPCs completed on the server, by method and status code.",
+	}, []string{"method", "code"})
+
+	GRPCHandlingSeconds = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "server_handling_seconds",
+		Help:      "Latency of RPCs handled by the server, by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+)
+
+// kafka metrics
+var (
+	KafkaReaderLag = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "reader_lag",
+		Help:      "Number of messages the consumer is behind the end of the partition, by topic.",
+	}, []string{"topic"})
+
+	KafkaWriterErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "kafka",
+		Name:      "writer_errors_total",
+		Help:      "Number of messages the producer failed to deliver, by topic.",
+	}, []string{"topic"})
+)
+
+// cache metrics
+var (
+	CacheLookups = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Number of cache lookups, by entity type and result: hit, negative_hit, miss or error.",
+	}, []string{"entity_type", "result"})
+
+	CacheInvalidationErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "invalidation_errors_total",
+		Help:      "Number of cached entries that could not be dropped or announced, by entity type.",
+	}, []string{"entity_type"})
+)
+
+// domain metrics
+var (
+	UserSignups = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "user_signups_total",
+		Help:      "Number of users created.",
+	})
+
+	UsersImported = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "users_imported_rows_total",
+		Help:      "Number of rows of user imports that are not dry runs, by outcome.",
+	}, []string{"status"})
+
+	PasswordChanges = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "password_changes_total",
+		Help:      "Number of successful password changes, by account kind.",
+	}, []string{"kind"})
+
+	AccountsPurged = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "accounts_purged_total",
+		Help:      "Number of soft-deleted accounts erased after the retention period, by account kind.",
+	}, []string{"kind"})
+
+	AuditWriteErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "audit_write_errors_total",
+		Help:      "Number of audit log entries that could not be written, by entity type.",
+	}, []string{"entity_type"})
+
+	PermissionDenied = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "permission_denied_total",
+		Help:      "Number of requests rejected for a missing permission, by permission.",
+	}, []string{"permission"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
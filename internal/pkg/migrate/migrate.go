@@ -0,0 +1,294 @@
+// Package migrate applies the embedded schema migrations.
+//
+// Applied versions are tracked in the same schema_migrations table the golang-migrate CLI uses,
+// so databases migrated with the old Makefile target keep working.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+const versionTable = "schema_migrations"
+
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// DB is satisfied by *postgres.PostgresDB
+type DB interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Current uint64
+	Latest  uint64
+	Dirty   bool
+	Pending []Migration
+}
+
+type Migrator struct {
+	db         DB
+	migrations []Migration
+}
+
+func New(db DB, source fs.FS) (*Migrator, error) {
+	migrations, err := parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+func parse(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error during read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		match := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %s: %w", entry.Name(), err)
+		}
+
+		body, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("error during read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if match[3] == "up" {
+			migration.Up = string(body)
+		} else {
+			migration.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Latest returns the version the service code expects
+func (m *Migrator) Latest() uint64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) ensureVersionTable(ctx context.Context) error {
+	_, err := m.db.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+versionTable+` (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)`)
+	return err
+}
+
+func (m *Migrator) version(ctx context.Context) (uint64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := m.db.QueryRow(ctx, `SELECT version, dirty FROM `+versionTable+` LIMIT 1`).Scan(&version, &dirty)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	// golang-migrate stores -1 when every migration was rolled back
+	if version < 0 {
+		return 0, dirty, nil
+	}
+	return uint64(version), dirty, nil
+}
+
+func setVersion(ctx context.Context, tx pgx.Tx, version uint64, dirty bool) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM `+versionTable); err != nil {
+		return err
+	}
+	if version == 0 {
+		return nil
+	}
+	_, err := tx.Exec(ctx, `INSERT INTO `+versionTable+` (version, dirty) VALUES ($1, $2)`, int64(version), dirty)
+	return err
+}
+
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return nil, err
+	}
+
+	current, dirty, err := m.version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{Current: current, Latest: m.Latest(), Dirty: dirty}
+	for _, migration := range m.migrations {
+		if migration.Version > current {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+
+	return status, nil
+}
+
+// Check fails when the database schema is dirty or behind the embedded migrations
+func (m *Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("error during check schema version: %w", err)
+	}
+
+	if status.Dirty {
+		return fmt.Errorf("database schema version %d is dirty, fix it and run `migrate force %d`", status.Current, status.Current)
+	}
+	if status.Current < status.Latest {
+		return fmt.Errorf("database schema is at version %d, service needs %d, run `migrate up`", status.Current, status.Latest)
+	}
+
+	return nil
+}
+
+// Up applies every pending migration, each one in its own transaction
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.Dirty {
+		return nil, fmt.Errorf("database schema version %d is dirty", status.Current)
+	}
+
+	var applied []Migration
+	for _, migration := range status.Pending {
+		if err := m.apply(ctx, migration.Up, migration.Version); err != nil {
+			return applied, fmt.Errorf("error during apply migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		applied = append(applied, migration)
+	}
+
+	return applied, nil
+}
+
+// Down rolls back the last steps applied migrations
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.Dirty {
+		return nil, fmt.Errorf("database schema version %d is dirty", status.Current)
+	}
+
+	var reverted []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := m.migrations[i]
+		if migration.Version > status.Current {
+			continue
+		}
+
+		var previous uint64
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+		if err := m.apply(ctx, migration.Down, previous); err != nil {
+			return reverted, fmt.Errorf("error during revert migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		reverted = append(reverted, migration)
+	}
+
+	return reverted, nil
+}
+
+// Force records version as applied and clean without running any migration
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if err := m.ensureVersionTable(ctx); err != nil {
+		return err
+	}
+
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := setVersion(ctx, tx, version, false); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (m *Migrator) apply(ctx context.Context, body string, version uint64) error {
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, body); err != nil {
+		return err
+	}
+	if err := setVersion(ctx, tx, version, false); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// LoadFixtures runs every fixture file in name order
+func LoadFixtures(ctx context.Context, db DB, source fs.FS) ([]string, error) {
+	names, err := fs.Glob(source, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		body, err := fs.ReadFile(source, name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := db.Exec(ctx, string(body)); err != nil {
+			return nil, fmt.Errorf("error during load fixture %s: %w", name, err)
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,48 @@
+package migrate
+
+import (
+	"dennic_user_service/migrations"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrateTestSuite struct {
+	suite.Suite
+}
+
+func (s *MigrateTestSuite) TestParse() {
+	source := fstest.MapFS{
+		"000002_create_table_admins.up.sql":   {Data: []byte("CREATE TABLE admins ();")},
+		"000002_create_table_admins.down.sql": {Data: []byte("DROP TABLE admins;")},
+		"000001_create_table_users.up.sql":    {Data: []byte("CREATE TABLE users ();")},
+		"000001_create_table_users.down.sql":  {Data: []byte("DROP TABLE users;")},
+		"README.md":                           {Data: []byte("not a migration")},
+	}
+
+	parsed, err := parse(source)
+	s.Require().NoError(err)
+	s.Require().Len(parsed, 2)
+
+	s.Equal(uint64(1), parsed[0].Version)
+	s.Equal("create_table_users", parsed[0].Name)
+	s.Equal("CREATE TABLE users ();", parsed[0].Up)
+	s.Equal("DROP TABLE users;", parsed[0].Down)
+	s.Equal(uint64(2), parsed[1].Version)
+}
+
+func (s *MigrateTestSuite) TestEmbeddedMigrations() {
+	migrator, err := New(nil, migrations.FS)
+	s.Require().NoError(err)
+	s.NotZero(migrator.Latest())
+
+	for _, migration := range migrator.migrations {
+		s.NotEmpty(migration.Up, "migration %d has no up file", migration.Version)
+		s.NotEmpty(migration.Down, "migration %d has no down file", migration.Version)
+	}
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
@@ -2,25 +2,46 @@ package otlp
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"dennic_user_service/internal/pkg/app"
 	"dennic_user_service/internal/pkg/config"
+	"errors"
 	"fmt"
+	"os"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	ExporterGRPC   = "grpc"
+	ExporterHTTP   = "http"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
 )
 
 // Initializes an OTLP exporter, and configures the corresponding trace
 func InitOTLPProvider(config *config.Config) (func() error, error) {
 	var (
-		ctx           = context.Background()
-		otelAgentAddr = fmt.Sprintf("%s%s", config.OTLPCollector.Host, config.OTLPCollector.Port)
+		ctx = context.Background()
 	)
 
+	// set global propagator to tracecontext (the default is no-op).
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if config.OTLPCollector.Exporter == ExporterNone {
+		return func() error { return nil }, nil
+	}
+
 	res, err := resource.New(ctx,
 		resource.WithFromEnv(),
 		resource.WithProcess(),
@@ -29,20 +50,16 @@ func InitOTLPProvider(config *config.Config) (func() error, error) {
 		resource.WithAttributes(
 			// the service name used to display traces in backends
 			semconv.ServiceNameKey.String(config.APP),
-			semconv.ServiceVersionKey.String("1.0.0"),
+			semconv.ServiceVersionKey.String(app.Version),
 			semconv.DeploymentEnvironmentKey.String(config.Environment),
 		),
 	)
-	if err != nil {
+	// a partial resource is still usable, e.g. when OTEL_RESOURCE_ATTRIBUTES is malformed
+	if err != nil && !errors.Is(err, resource.ErrPartialResource) {
 		return nil, fmt.Errorf("otlp collector failed to create resource: %w", err)
 	}
 
-	traceClient := otlptracegrpc.NewClient(
-		otlptracegrpc.WithInsecure(),
-		otlptracegrpc.WithEndpoint(otelAgentAddr),
-	)
-
-	traceExporter, err := otlptrace.New(ctx, traceClient)
+	traceExporter, err := newExporter(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("otlp collector failed to create trace exporter: %w", err)
 	}
@@ -51,13 +68,12 @@ func InitOTLPProvider(config *config.Config) (func() error, error) {
 	// span processor to aggregate spans before export.
 	bsp := sdktrace.NewBatchSpanProcessor(traceExporter)
 	tracerProvider := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		// respect the caller's sampling decision and sample root spans by ratio
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.OTLPCollector.SampleRatio))),
 		sdktrace.WithResource(res),
 		sdktrace.WithSpanProcessor(bsp),
 	)
 
-	// set global propagator to tracecontext (the default is no-op).
-	otel.SetTextMapPropagator(propagation.TraceContext{})
 	otel.SetTracerProvider(tracerProvider)
 
 	return func() error {
@@ -68,3 +84,62 @@ func InitOTLPProvider(config *config.Config) (func() error, error) {
 		return nil
 	}, nil
 }
+
+func newExporter(ctx context.Context, config *config.Config) (sdktrace.SpanExporter, error) {
+	var (
+		otelAgentAddr = fmt.Sprintf("%s%s", config.OTLPCollector.Host, config.OTLPCollector.Port)
+		insecure      = config.OTLPCollector.Insecure
+	)
+
+	switch config.OTLPCollector.Exporter {
+	case ExporterStdout:
+		return stdouttrace.New()
+	case ExporterHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(otelAgentAddr)}
+		if insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		} else {
+			tlsConfig, err := newTLSConfig(config.OTLPCollector.CACert)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlptrace.New(ctx, otlptracehttp.NewClient(opts...))
+	case ExporterGRPC, "":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(otelAgentAddr)}
+		if insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		} else {
+			tlsConfig, err := newTLSConfig(config.OTLPCollector.CACert)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		return otlptrace.New(ctx, otlptracegrpc.NewClient(opts...))
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", config.OTLPCollector.Exporter)
+	}
+}
+
+// newTLSConfig uses the system roots unless a CA certificate file is given
+func newTLSConfig(caFile string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile == "" {
+		return tlsConfig, nil
+	}
+
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("otlp collector failed to read ca certificate: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("otlp collector failed to parse ca certificate %s", caFile)
+	}
+	tlsConfig.RootCAs = pool
+
+	return tlsConfig, nil
+}
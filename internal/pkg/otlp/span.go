@@ -6,9 +6,12 @@ import (
 	otelpkg "go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
+const AttrDBRowsAffected = attribute.Key("db.rows_affected")
+
 type Span interface {
 	trace.Span
 	EndError(err error, options ...trace.SpanEndOption)
@@ -67,10 +70,24 @@ func (s *span) TracerProvider() trace.TracerProvider {
 
 func (s *span) Error(err error) {
 	if err != nil {
+		s.span.RecordError(err)
 		s.span.SetStatus(codes.Error, err.Error())
 	}
 }
 
+// DBAttributes describes a postgres statement executed against table
+func DBAttributes(table, statement string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.DBSystemPostgreSQL,
+		semconv.DBSQLTableKey.String(table),
+		semconv.DBStatementKey.String(statement),
+	}
+}
+
+func RowsAffected(rows int64) attribute.KeyValue {
+	return AttrDBRowsAffected.Int64(rows)
+}
+
 // RestoreTraceContext function forms context and span from trace_id and span_id
 //
 // span_id and trace_id should both be strings in hex format.
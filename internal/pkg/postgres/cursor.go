@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// Cursor runs query through a server side cursor in a read only transaction and calls fn with
+// the values of every row, at most batch rows are held in memory at a time
+func (p *PostgresDB) Cursor(ctx context.Context, name string, batch int, query string, args []interface{}, fn func([]interface{}) error) error {
+	tx, err := p.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf("DECLARE %s NO SCROLL CURSOR FOR %s", pgx.Identifier{name}.Sanitize(), query), args...); err != nil {
+		return p.Error(err)
+	}
+	fetch := fmt.Sprintf("FETCH %d FROM %s", batch, pgx.Identifier{name}.Sanitize())
+	for {
+		fetched, err := p.fetch(ctx, tx, fetch, fn)
+		if err != nil {
+			return err
+		}
+		if fetched < batch {
+			break
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (p *PostgresDB) fetch(ctx context.Context, tx pgx.Tx, fetch string, fn func([]interface{}) error) (int, error) {
+	rows, err := tx.Query(ctx, fetch)
+	if err != nil {
+		return 0, p.Error(err)
+	}
+	defer rows.Close()
+
+	var fetched int
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return fetched, p.Error(err)
+		}
+		if err := fn(values); err != nil {
+			return fetched, err
+		}
+		fetched++
+	}
+
+	return fetched, p.Error(rows.Err())
+}
@@ -0,0 +1,39 @@
+// Package reqinfo carries who made a request and where it came from through the context
+package reqinfo
+
+import "context"
+
+// metadata keys set by the api gateway
+const (
+	MetadataActorId      = "x-actor-id"
+	MetadataActorType    = "x-actor-type"
+	MetadataRequestId    = "x-request-id"
+	MetadataForwardedFor = "x-forwarded-for"
+)
+
+type Info struct {
+	ActorId   string
+	ActorType string
+	RequestId string
+	IP        string
+	// Internal marks calls the service makes itself, they run without an actor
+	Internal bool
+}
+
+type ctxKey struct{}
+
+func With(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, ctxKey{}, info)
+}
+
+// Internal marks ctx as a call the service makes itself, such as a kafka consumer, a job or a
+// command line tool. Requests from clients never are, so one without an actor is denied
+func Internal(ctx context.Context) context.Context {
+	return With(ctx, Info{Internal: true})
+}
+
+// From returns the request info stored in ctx, the zero Info when none was stored
+func From(ctx context.Context) Info {
+	info, _ := ctx.Value(ctxKey{}).(Info)
+	return info
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/metrics"
 	"dennic_user_service/internal/pkg/otlp"
 	"time"
 )
@@ -17,24 +18,37 @@ type AdminStorageI interface {
 	Create(ctx context.Context, admin *entity.Admin) (string, error)
 	Get(ctx context.Context, params map[string]string) (*entity.Admin, error)
 	List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Admin, error)
-	Update(ctx context.Context, kyc *entity.Admin) error
+	Update(ctx context.Context, kyc *entity.Admin, fields []string) error
 	Delete(ctx context.Context, id string) error
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	IfExists(ctx context.Context, req *entity.IfAdminExistsReq) (*entity.IfExistsResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeAdminPasswordReq) (*entity.ChangeAdminPasswordResp, error)
 	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Admin, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	ListAuditLog(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditEntry, error)
+	Terminate(ctx context.Context, id, endDate string) (*entity.Admin, error)
+	ListSalaryHistory(ctx context.Context, adminId string) ([]*entity.SalaryChange, error)
+	RecomputeTenure(ctx context.Context) (int64, error)
+	BatchGet(ctx context.Context, ids []string) ([]*entity.Admin, []string, error)
 }
 
-
 type adminService struct {
 	repo       repository.AdminStorageI
+	audit      auditLog
+	authz      Authorizer
 	ctxTimeout time.Duration
 }
 
-func NewAdminService(ctxTimeout time.Duration, repo repository.AdminStorageI) adminService {
+// NewAdminService records every mutation in audit, a nil audit storage disables the audit log,
+// authz checks the permissions of the acting admin
+func NewAdminService(ctxTimeout time.Duration, repo repository.AdminStorageI, audit repository.AuditStorageI, authz Authorizer) adminService {
 	return adminService{
 		ctxTimeout: ctxTimeout,
 		repo:       repo,
+		audit:      auditLog{repo: audit},
+		authz:      authz,
 	}
 }
 
@@ -45,7 +59,21 @@ func (a adminService) Create(ctx context.Context, admin *entity.Admin) (string,
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Create")
 	defer span.End()
 
-	return admin.Id, a.repo.Create(ctx, admin)
+	if err := a.authorizeRole(ctx, entity.PermissionAdminsWrite); err != nil {
+		return "", err
+	}
+	if err := setWorkYears(admin, time.Now()); err != nil {
+		return "", err
+	}
+
+	if err := a.repo.Create(ctx, admin); err != nil {
+		return "", err
+	}
+
+	a.audit.record(ctx, entity.AuditEntityAdmin, admin.Id, entity.AuditActionCreate,
+		auditChanges(fieldNames(adminAuditFields), adminAuditFields, &entity.Admin{}, admin))
+
+	return admin.Id, nil
 }
 
 func (a adminService) Get(ctx context.Context, params map[string]string) (*entity.Admin, error) {
@@ -55,6 +83,10 @@ func (a adminService) Get(ctx context.Context, params map[string]string) (*entit
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Get")
 	defer span.End()
 
+	if err := authorizeSelf(ctx, a.authz, entity.ActorTypeAdmin, params["id"], entity.PermissionAdminsRead); err != nil {
+		return nil, err
+	}
+
 	return a.repo.Get(ctx, params)
 }
 
@@ -65,17 +97,74 @@ func (a adminService) List(ctx context.Context, limit, offset uint64, filter map
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"List")
 	defer span.End()
 
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsRead); err != nil {
+		return nil, err
+	}
+
 	return a.repo.List(ctx, limit, offset, filter)
 }
 
-func (a adminService) Update(ctx context.Context, req *entity.Admin) error {
+// Update writes only fields of the admin, all set fields when it is empty
+func (a adminService) Update(ctx context.Context, req *entity.Admin, fields []string) error {
 	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
 	defer cancel()
 
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Update")
 	defer span.End()
 
-	return a.repo.Update(ctx, req)
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsWrite); err != nil {
+		return err
+	}
+
+	// the version the client read is required, otherwise concurrent edits overwrite each other
+	if req.Version == 0 {
+		return entity.NewErrNoRequiredParameter("version")
+	}
+
+	if req.Password != "" {
+		return passwordUpdateError()
+	}
+	fields, err := updateMask(fields, adminUpdateFields, req)
+	if err != nil {
+		return err
+	}
+	if containsField(fields, "role") {
+		if err := a.authorizeRole(ctx, entity.PermissionAdminsWrite); err != nil {
+			return err
+		}
+	}
+	req.UpdatedAt = time.Now()
+
+	before, err := a.repo.Get(ctx, map[string]string{"id": req.Id})
+	if err != nil {
+		return err
+	}
+	if containsField(fields, "start_work_year") || containsField(fields, "end_work_year") {
+		employment := entity.Admin{StartWorkYear: before.StartWorkYear, EndWorkYear: before.EndWorkYear}
+		if containsField(fields, "start_work_year") {
+			employment.StartWorkYear = req.StartWorkYear
+		}
+		if containsField(fields, "end_work_year") {
+			employment.EndWorkYear = req.EndWorkYear
+		}
+		if err := setWorkYears(&employment, time.Now()); err != nil {
+			return err
+		}
+		req.WorkYears = employment.WorkYears
+		fields = append(fields, "work_years")
+	}
+	if err := a.repo.Update(ctx, req, fields); err != nil {
+		return err
+	}
+	// the role grants the permissions of the admin
+	if containsField(fields, "role") {
+		a.authz.Invalidate(req.Id)
+	}
+
+	a.audit.record(ctx, entity.AuditEntityAdmin, req.Id, entity.AuditActionUpdate,
+		auditChanges(fields, adminAuditFields, before, req))
+
+	return nil
 }
 
 func (a adminService) Delete(ctx context.Context, guid string) error {
@@ -85,7 +174,17 @@ func (a adminService) Delete(ctx context.Context, guid string) error {
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Delete")
 	defer span.End()
 
-	return a.repo.Delete(ctx, guid)
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsDelete); err != nil {
+		return err
+	}
+
+	if err := a.repo.Delete(ctx, guid); err != nil {
+		return err
+	}
+	a.authz.Invalidate(guid)
+	a.audit.record(ctx, entity.AuditEntityAdmin, guid, entity.AuditActionDelete, nil)
+
+	return nil
 }
 
 func (a adminService) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
@@ -115,7 +214,17 @@ func (a adminService) ChangePassword(ctx context.Context, req *entity.ChangeAdmi
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ChangePassword")
 	defer span.End()
 
-	return a.repo.ChangePassword(ctx, req)
+	resp, err := a.repo.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status {
+		metrics.PasswordChanges.WithLabelValues("admin").Inc()
+		a.audit.record(ctx, entity.AuditEntityAdmin, resp.Id, entity.AuditActionChangePassword,
+			map[string]entity.AuditChange{"password": secretChange()})
+	}
+
+	return resp, nil
 }
 
 func (a adminService) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
@@ -125,5 +234,174 @@ func (a adminService) UpdateRefreshToken(ctx context.Context, req *entity.Update
 	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"UpdateRefreshToken")
 	defer span.End()
 
-	return a.repo.UpdateRefreshToken(ctx, req)
+	resp, err := a.repo.UpdateRefreshToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status {
+		a.audit.record(ctx, entity.AuditEntityAdmin, req.Id, entity.AuditActionUpdateRefreshToken,
+			map[string]entity.AuditChange{"refresh_token": secretChange()})
+	}
+
+	return resp, nil
+}
+
+func (a adminService) ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.Admin, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ListDeleted")
+	defer span.End()
+
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsRead); err != nil {
+		return nil, err
+	}
+
+	return a.repo.ListDeleted(ctx, limit, offset, filter)
+}
+
+func (a adminService) Restore(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Restore")
+	defer span.End()
+
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsWrite); err != nil {
+		return err
+	}
+
+	if err := a.repo.Restore(ctx, id); err != nil {
+		return err
+	}
+	a.authz.Invalidate(id)
+	a.audit.record(ctx, entity.AuditEntityAdmin, id, entity.AuditActionRestore, nil)
+
+	return nil
+}
+
+// Purge hard-deletes admins soft-deleted longer than retention ago
+func (a adminService) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Purge")
+	defer span.End()
+
+	purged, err := a.repo.Purge(ctx, retention)
+	if err != nil {
+		return 0, err
+	}
+	metrics.AccountsPurged.WithLabelValues("admin").Add(float64(purged))
+
+	return purged, nil
+}
+
+// authorizeRole checks permission and roles.write, the role of an admin grants permissions
+// so setting it requires both
+func (a adminService) authorizeRole(ctx context.Context, permission string) error {
+	if err := a.authz.Authorize(ctx, permission); err != nil {
+		return err
+	}
+	return a.authz.Authorize(ctx, entity.PermissionRolesWrite)
+}
+
+func containsField(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ListAuditLog returns the audit entries of users and admins, newest first
+func (a adminService) ListAuditLog(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ListAuditLog")
+	defer span.End()
+
+	if err := a.authz.Authorize(ctx, entity.PermissionAuditRead); err != nil {
+		return nil, err
+	}
+
+	if a.audit.repo == nil {
+		return nil, nil
+	}
+	return a.audit.repo.List(ctx, limit, offset, filter)
+}
+
+// Terminate ends the employment of an admin on endDate, today when it is empty,
+// the admin keeps its record but its sessions can not be renewed and it can not log in
+func (a adminService) Terminate(ctx context.Context, id, endDate string) (*entity.Admin, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"Terminate")
+	defer span.End()
+
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsWrite); err != nil {
+		return nil, err
+	}
+
+	admin, err := a.repo.Get(ctx, map[string]string{"id": id})
+	if err != nil {
+		return nil, err
+	}
+	before := *admin
+
+	now := time.Now()
+	if endDate == "" {
+		endDate = now.Format(dateLayout)
+	}
+	admin.EndWorkYear = endDate
+	if err := setWorkYears(admin, now); err != nil {
+		return nil, err
+	}
+
+	admin.TerminatedAt, err = a.repo.Terminate(ctx, id, admin.EndWorkYear, admin.WorkYears)
+	if err != nil {
+		return nil, err
+	}
+	admin.RefreshToken = ""
+	admin.Version++
+	a.authz.Invalidate(id)
+
+	a.audit.record(ctx, entity.AuditEntityAdmin, id, entity.AuditActionTerminate,
+		auditChanges([]string{"end_work_year", "work_years"}, adminAuditFields, &before, admin))
+
+	return admin, nil
+}
+
+// ListSalaryHistory returns every salary of the admin, the newest first
+func (a adminService) ListSalaryHistory(ctx context.Context, adminId string) ([]*entity.SalaryChange, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"ListSalaryHistory")
+	defer span.End()
+
+	if err := authorizeSelf(ctx, a.authz, entity.ActorTypeAdmin, adminId, entity.PermissionAdminsRead); err != nil {
+		return nil, err
+	}
+
+	return a.repo.ListSalaryHistory(ctx, adminId)
+}
+
+// RecomputeTenure refreshes the work years of every admin as time passes
+func (a adminService) RecomputeTenure(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"RecomputeTenure")
+	defer span.End()
+
+	ids, err := a.repo.RecomputeWorkYears(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(len(ids)), nil
 }
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AdminTestSuite struct {
+	suite.Suite
+	roles *roleStorageStub
+	admin adminService
+}
+
+// adminUpdateStub keeps a single admin, changes to it are made on the role storage by the tests
+type adminUpdateStub struct {
+	repository.AdminStorageI
+}
+
+func (a *adminUpdateStub) Get(_ context.Context, params map[string]string) (*entity.Admin, error) {
+	return &entity.Admin{Id: params["id"], Role: "admin", Version: 1}, nil
+}
+
+func (a *adminUpdateStub) Update(context.Context, *entity.Admin, []string) error {
+	return nil
+}
+
+func (a *adminUpdateStub) Delete(context.Context, string) error {
+	return nil
+}
+
+func (a *adminUpdateStub) Restore(context.Context, string) error {
+	return nil
+}
+
+func (s *AdminTestSuite) SetupTest() {
+	s.roles = &roleStorageStub{permissions: map[string][]string{
+		"root":  {entity.PermissionAll},
+		"staff": {entity.PermissionUsersWrite},
+	}}
+	s.admin = NewAdminService(time.Second, &adminUpdateStub{}, nil, NewRoleService(time.Second, s.roles, time.Hour))
+}
+
+// staffCanWrite authorizes staff, the first call caches its permissions for the rest of the test
+func (s *AdminTestSuite) staffCanWrite() bool {
+	return s.admin.authz.Authorize(actor(entity.ActorTypeAdmin, "staff"), entity.PermissionUsersWrite) == nil
+}
+
+func (s *AdminTestSuite) TestRoleUpdateInvalidatesPermissions() {
+	s.Require().True(s.staffCanWrite())
+
+	// changing other fields keeps the cached permissions
+	s.roles.permissions["staff"] = nil
+	s.Require().NoError(s.admin.Update(actor(entity.ActorTypeAdmin, "root"), &entity.Admin{Id: "staff", FirstName: "Vali", Version: 1}, nil))
+	s.True(s.staffCanWrite())
+
+	s.Require().NoError(s.admin.Update(actor(entity.ActorTypeAdmin, "root"), &entity.Admin{Id: "staff", Role: "doctor", Version: 1}, []string{"role"}))
+	s.False(s.staffCanWrite())
+}
+
+func (s *AdminTestSuite) TestDeleteInvalidatesPermissions() {
+	s.Require().True(s.staffCanWrite())
+
+	// a deleted admin resolves no permission
+	s.roles.permissions["staff"] = nil
+	s.Require().NoError(s.admin.Delete(actor(entity.ActorTypeAdmin, "root"), "staff"))
+	s.False(s.staffCanWrite())
+}
+
+func (s *AdminTestSuite) TestRestoreInvalidatesPermissions() {
+	s.roles.permissions["staff"] = nil
+	s.Require().False(s.staffCanWrite())
+
+	s.roles.permissions["staff"] = []string{entity.PermissionUsersWrite}
+	s.Require().NoError(s.admin.Restore(actor(entity.ActorTypeAdmin, "root"), "staff"))
+	s.True(s.staffCanWrite())
+}
+
+func TestAdminTestSuite(t *testing.T) {
+	suite.Run(t, new(AdminTestSuite))
+}
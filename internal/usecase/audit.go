@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/metrics"
+	"dennic_user_service/internal/pkg/reqinfo"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const maskedValue = "******"
+
+// auditSecretFields never reach the audit log in clear text
+var auditSecretFields = map[string]bool{
+	"password":      true,
+	"refresh_token": true,
+	// medical data is encrypted at rest, the audit log only shows that it changed
+	"blood_type":         true,
+	"allergies":          true,
+	"chronic_conditions": true,
+	"medications":        true,
+}
+
+type auditLog struct {
+	repo repository.AuditStorageI
+}
+
+// record writes an audit entry for a mutation that already succeeded, a failed write is counted
+// and attached to the current span instead of failing the mutation
+func (a auditLog) record(ctx context.Context, entityType, entityId, action string, changes map[string]entity.AuditChange) {
+	if a.repo == nil {
+		return
+	}
+
+	info := reqinfo.From(ctx)
+	entry := &entity.AuditEntry{
+		EntityType: entityType,
+		EntityId:   entityId,
+		Action:     action,
+		ActorId:    info.ActorId,
+		ActorType:  info.ActorType,
+		RequestId:  info.RequestId,
+		IP:         info.IP,
+		Changes:    changes,
+	}
+	if err := a.repo.Create(ctx, entry); err != nil {
+		metrics.AuditWriteErrors.WithLabelValues(entityType).Inc()
+		trace.SpanFromContext(ctx).RecordError(err)
+	}
+}
+
+// auditChanges compares the listed fields of before and after, created items are compared
+// against an empty one
+func auditChanges[T any](fields []string, values map[string]func(T) string, before, after T) map[string]entity.AuditChange {
+	changes := make(map[string]entity.AuditChange)
+	for _, field := range fields {
+		change := entity.AuditChange{
+			Before: values[field](before),
+			After:  values[field](after),
+		}
+		if change.Before == change.After {
+			continue
+		}
+
+		if auditSecretFields[field] {
+			change = maskChange(change)
+		}
+		changes[field] = change
+	}
+
+	return changes
+}
+
+// secretChange records that a secret field changed without its values
+func secretChange() entity.AuditChange {
+	return entity.AuditChange{Before: maskedValue, After: maskedValue}
+}
+
+func maskChange(change entity.AuditChange) entity.AuditChange {
+	if change.Before != "" {
+		change.Before = maskedValue
+	}
+	if change.After != "" {
+		change.After = maskedValue
+	}
+	return change
+}
+
+func fieldNames[T any](values map[string]func(T) string) []string {
+	fields := make([]string, 0, len(values))
+	for field := range values {
+		fields = append(fields, field)
+	}
+	return fields
+}
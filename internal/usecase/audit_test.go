@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+}
+
+type auditStorageStub struct {
+	entries []*entity.AuditEntry
+	err     error
+}
+
+func (a *auditStorageStub) Create(_ context.Context, entry *entity.AuditEntry) error {
+	a.entries = append(a.entries, entry)
+	return a.err
+}
+
+func (a *auditStorageStub) List(context.Context, uint64, uint64, map[string]string) ([]*entity.AuditEntry, error) {
+	return a.entries, a.err
+}
+
+func (s *AuditTestSuite) TestChangesOnlyListChangedFields() {
+	before := &entity.User{FirstName: "John", LastName: "Doe"}
+	after := &entity.User{FirstName: "Jane", LastName: "Doe"}
+
+	changes := auditChanges([]string{"first_name", "last_name"}, userUpdateFields, before, after)
+	s.Equal(map[string]entity.AuditChange{
+		"first_name": {Before: "John", After: "Jane"},
+	}, changes)
+}
+
+func (s *AuditTestSuite) TestChangesMaskSecrets() {
+	before := &entity.User{Password: "old"}
+	after := &entity.User{Password: "new"}
+
+	changes := auditChanges([]string{"password"}, userAuditFields, before, after)
+	s.Equal(entity.AuditChange{Before: maskedValue, After: maskedValue}, changes["password"])
+
+	created := auditChanges(fieldNames(userAuditFields), userAuditFields, &entity.User{}, after)
+	s.Equal(entity.AuditChange{After: maskedValue}, created["password"])
+}
+
+func (s *AuditTestSuite) TestRecordTakesActorFromContext() {
+	storage := &auditStorageStub{}
+	ctx := reqinfo.With(context.Background(), reqinfo.Info{
+		ActorId:   "9a4a5c10-5c0e-4f4e-8f60-1b1b4f0d3f11",
+		ActorType: "admin",
+		RequestId: "request",
+		IP:        "10.0.0.1",
+	})
+
+	auditLog{repo: storage}.record(ctx, entity.AuditEntityUser, "id", entity.AuditActionDelete, nil)
+	s.Require().Len(storage.entries, 1)
+	s.Equal("admin", storage.entries[0].ActorType)
+	s.Equal("request", storage.entries[0].RequestId)
+	s.Equal("10.0.0.1", storage.entries[0].IP)
+}
+
+func (s *AuditTestSuite) TestRecordIgnoresStorageErrors() {
+	storage := &auditStorageStub{err: errors.New("unavailable")}
+
+	s.NotPanics(func() {
+		auditLog{repo: storage}.record(internalCall(), entity.AuditEntityAdmin, "id", entity.AuditActionCreate, nil)
+	})
+	auditLog{}.record(internalCall(), entity.AuditEntityAdmin, "id", entity.AuditActionCreate, nil)
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/metrics"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"sync"
+	"time"
+)
+
+// Authorizer checks that the actor of a request holds a permission
+type Authorizer interface {
+	Authorize(ctx context.Context, permission string) error
+	// Invalidate forgets the resolved permissions of an admin whose access changed
+	Invalidate(adminId string)
+}
+
+// authorizeSelf lets an actor act on its own account, anyone else needs permission
+func authorizeSelf(ctx context.Context, authz Authorizer, actorType, id, permission string) error {
+	info := reqinfo.From(ctx)
+	if info.ActorType == actorType && info.ActorId != "" && info.ActorId == id {
+		return nil
+	}
+	return authz.Authorize(ctx, permission)
+}
+
+func hasPermission(permissions map[string]bool, permission string) bool {
+	return permissions[entity.PermissionAll] || permissions[permission]
+}
+
+func denied(permission string) error {
+	metrics.PermissionDenied.WithLabelValues(permission).Inc()
+	return entity.NewErrPermissionDenied(permission)
+}
+
+// permissionCache keeps the resolved permissions of admins for ttl, a zero ttl disables it
+type permissionCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]permissionCacheEntry
+}
+
+type permissionCacheEntry struct {
+	permissions map[string]bool
+	expiresAt   time.Time
+}
+
+func newPermissionCache(ttl time.Duration) *permissionCache {
+	return &permissionCache{
+		ttl:     ttl,
+		entries: make(map[string]permissionCacheEntry),
+	}
+}
+
+func (c *permissionCache) get(adminId string) (map[string]bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[adminId]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(c.entries, adminId)
+		return nil, false
+	}
+	return entry.permissions, true
+}
+
+func (c *permissionCache) set(adminId string, permissions map[string]bool) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[adminId] = permissionCacheEntry{
+		permissions: permissions,
+		expiresAt:   time.Now().Add(c.ttl),
+	}
+}
+
+// invalidate drops one admin, or every admin when adminId is empty
+func (c *permissionCache) invalidate(adminId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if adminId == "" {
+		c.entries = make(map[string]permissionCacheEntry)
+		return
+	}
+	delete(c.entries, adminId)
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuthorizeTestSuite struct {
+	suite.Suite
+	repo *roleStorageStub
+	role roleService
+}
+
+// roleStorageStub resolves permissions from a map and counts the lookups
+type roleStorageStub struct {
+	repository.RoleStorageI
+	permissions map[string][]string
+	lookups     int
+}
+
+func (r *roleStorageStub) AdminPermissions(_ context.Context, adminId string) ([]string, error) {
+	r.lookups++
+	return r.permissions[adminId], nil
+}
+
+func (r *roleStorageStub) Assign(context.Context, string, string, string) error {
+	return nil
+}
+
+func (s *AuthorizeTestSuite) SetupTest() {
+	s.repo = &roleStorageStub{permissions: map[string][]string{
+		"reader": {entity.PermissionUsersRead},
+		"root":   {entity.PermissionAll},
+	}}
+	s.role = NewRoleService(time.Second, s.repo, time.Minute)
+}
+
+func actor(actorType, actorId string) context.Context {
+	return reqinfo.With(context.Background(), reqinfo.Info{ActorType: actorType, ActorId: actorId})
+}
+
+// internalCall is the context of a job or a kafka consumer, it holds every permission
+func internalCall() context.Context {
+	return reqinfo.Internal(context.Background())
+}
+
+func (s *AuthorizeTestSuite) TestAdminPermissions() {
+	s.NoError(s.role.Authorize(actor(entity.ActorTypeAdmin, "reader"), entity.PermissionUsersRead))
+
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(s.role.Authorize(actor(entity.ActorTypeAdmin, "reader"), entity.PermissionUsersWrite), &errDenied)
+	s.ErrorAs(s.role.Authorize(actor(entity.ActorTypeAdmin, "unknown"), entity.PermissionUsersRead), &errDenied)
+
+	s.NoError(s.role.Authorize(actor(entity.ActorTypeAdmin, "root"), entity.PermissionAdminsDelete))
+}
+
+func (s *AuthorizeTestSuite) TestActorTypes() {
+	s.NoError(s.role.Authorize(internalCall(), entity.PermissionAdminsDelete))
+
+	// a request that names no actor is not an internal call
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(s.role.Authorize(context.Background(), entity.PermissionUsersRead), &errDenied)
+	s.ErrorAs(s.role.Authorize(reqinfo.With(context.Background(), reqinfo.Info{IP: "10.0.0.1"}), entity.PermissionUsersRead), &errDenied)
+	s.ErrorAs(s.role.Authorize(actor(entity.ActorTypeUser, "root"), entity.PermissionUsersRead), &errDenied)
+}
+
+func (s *AuthorizeTestSuite) TestSelf() {
+	ctx := actor(entity.ActorTypeUser, "user")
+	s.NoError(authorizeSelf(ctx, s.role, entity.ActorTypeUser, "user", entity.PermissionUsersWrite))
+
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(authorizeSelf(ctx, s.role, entity.ActorTypeUser, "other", entity.PermissionUsersWrite), &errDenied)
+	s.ErrorAs(authorizeSelf(ctx, s.role, entity.ActorTypeAdmin, "user", entity.PermissionAdminsRead), &errDenied)
+}
+
+func (s *AuthorizeTestSuite) TestCache() {
+	ctx := actor(entity.ActorTypeAdmin, "reader")
+	s.NoError(s.role.Authorize(ctx, entity.PermissionUsersRead))
+	s.NoError(s.role.Authorize(ctx, entity.PermissionUsersRead))
+	s.Equal(1, s.repo.lookups)
+
+	// assigning a role drops the cached permissions of the admin
+	s.Require().NoError(s.role.Assign(actor(entity.ActorTypeAdmin, "root"), "reader", "role"))
+	s.repo.permissions["reader"] = append(s.repo.permissions["reader"], entity.PermissionUsersWrite)
+	s.NoError(s.role.Authorize(ctx, entity.PermissionUsersWrite))
+	s.Equal(3, s.repo.lookups)
+}
+
+func (s *AuthorizeTestSuite) TestValidateRole() {
+	s.NoError(validateRole(&entity.Role{Name: "support", Permissions: []string{entity.PermissionUsersRead}}))
+
+	var errValidation *entity.ErrValidation
+	s.Require().ErrorAs(validateRole(&entity.Role{Permissions: []string{"users.fly"}}), &errValidation)
+	s.Contains(errValidation.Errors, "name")
+	s.Contains(errValidation.Errors, "permissions")
+}
+
+func TestAuthorizeTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizeTestSuite))
+}
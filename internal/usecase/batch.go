@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// maxBatchGetIds bounds the ids of a single batch get
+const maxBatchGetIds = 100
+
+// BatchGet returns the live users among ids in the order they were asked for, each once, and the ids
+// that matched no user, emergency contacts are not loaded
+func (u userService) BatchGet(ctx context.Context, ids []string) ([]*entity.User, []string, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"BatchGet")
+	defer span.End()
+
+	if err := u.authz.Authorize(ctx, entity.PermissionUsersRead); err != nil {
+		return nil, nil, err
+	}
+
+	unique, valid, err := batchIds(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	found := make(map[string]*entity.User, len(valid))
+	if len(valid) != 0 {
+		users, err := u.repo.GetMany(ctx, valid)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, user := range users {
+			found[user.Id] = user
+		}
+	}
+
+	var (
+		users   []*entity.User
+		missing []string
+	)
+	for _, id := range unique {
+		if user, ok := found[id]; ok {
+			users = append(users, user)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return users, missing, nil
+}
+
+// BatchGet returns the active admins among ids in the order they were asked for, each once, and the
+// ids that matched no admin
+func (a adminService) BatchGet(ctx context.Context, ids []string) ([]*entity.Admin, []string, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, AdminServiceName, AdinSpanName+"BatchGet")
+	defer span.End()
+
+	if err := a.authz.Authorize(ctx, entity.PermissionAdminsRead); err != nil {
+		return nil, nil, err
+	}
+
+	unique, valid, err := batchIds(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	found := make(map[string]*entity.Admin, len(valid))
+	if len(valid) != 0 {
+		admins, err := a.repo.GetMany(ctx, valid)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, admin := range admins {
+			found[admin.Id] = admin
+		}
+	}
+
+	var (
+		admins  []*entity.Admin
+		missing []string
+	)
+	for _, id := range unique {
+		if admin, ok := found[id]; ok {
+			admins = append(admins, admin)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return admins, missing, nil
+}
+
+// batchIds returns the ids of a batch get without repeats and those of them that are uuids in
+// canonical form, the others can match nothing and are not queried
+func batchIds(ids []string) (unique, valid []string, err error) {
+	if len(ids) == 0 {
+		return nil, nil, entity.NewErrNoRequiredParameter("ids")
+	}
+	if len(ids) > maxBatchGetIds {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("too many ids")
+		errValidation.Errors["ids"] = fmt.Sprintf("a batch may have at most %d ids", maxBatchGetIds)
+		return nil, nil, errValidation
+	}
+
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+		if parsed, err := uuid.Parse(id); err == nil && parsed.String() == id {
+			valid = append(valid, id)
+		}
+	}
+
+	return unique, valid, nil
+}
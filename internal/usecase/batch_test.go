@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchGetTestSuite struct {
+	suite.Suite
+	users  *batchUserStub
+	admins *batchAdminStub
+	ids    []string
+}
+
+// batchUserStub finds the users it holds and records the ids it was queried with
+type batchUserStub struct {
+	repository.UserStorageI
+	users   map[string]*entity.User
+	queried []string
+}
+
+func (b *batchUserStub) GetMany(_ context.Context, ids []string) ([]*entity.User, error) {
+	b.queried = ids
+	var users []*entity.User
+	for _, id := range ids {
+		if user, ok := b.users[id]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+type batchAdminStub struct {
+	repository.AdminStorageI
+	admins map[string]*entity.Admin
+}
+
+func (b *batchAdminStub) GetMany(_ context.Context, ids []string) ([]*entity.Admin, error) {
+	var admins []*entity.Admin
+	for _, id := range ids {
+		if admin, ok := b.admins[id]; ok {
+			admins = append(admins, admin)
+		}
+	}
+	return admins, nil
+}
+
+func (s *BatchGetTestSuite) SetupTest() {
+	s.ids = []string{uuid.New().String(), uuid.New().String(), uuid.New().String()}
+	s.users = &batchUserStub{users: map[string]*entity.User{
+		s.ids[0]: {Id: s.ids[0]},
+		s.ids[2]: {Id: s.ids[2]},
+	}}
+	s.admins = &batchAdminStub{admins: map[string]*entity.Admin{
+		s.ids[1]: {Id: s.ids[1]},
+	}}
+}
+
+func (s *BatchGetTestSuite) TestBatchGetUsers() {
+	user := NewUserService(time.Second, s.users, nil, NewRoleService(time.Second, nil, 0))
+
+	users, missing, err := user.BatchGet(internalCall(), []string{s.ids[2], "not-a-uuid", s.ids[1], s.ids[0], s.ids[2]})
+	s.Require().NoError(err)
+	s.Require().Len(users, 2)
+	s.Equal(s.ids[2], users[0].Id)
+	s.Equal(s.ids[0], users[1].Id)
+	s.Equal([]string{"not-a-uuid", s.ids[1]}, missing)
+	s.Equal([]string{s.ids[2], s.ids[1], s.ids[0]}, s.users.queried)
+
+	var errDenied *entity.ErrPermissionDenied
+	_, _, err = user.BatchGet(actingUser(s.ids[0]), []string{s.ids[0]})
+	s.ErrorAs(err, &errDenied)
+}
+
+func (s *BatchGetTestSuite) TestBatchGetAdmins() {
+	admin := NewAdminService(time.Second, s.admins, nil, NewRoleService(time.Second, nil, 0))
+
+	admins, missing, err := admin.BatchGet(internalCall(), s.ids)
+	s.Require().NoError(err)
+	s.Require().Len(admins, 1)
+	s.Equal(s.ids[1], admins[0].Id)
+	s.Equal([]string{s.ids[0], s.ids[2]}, missing)
+}
+
+func (s *BatchGetTestSuite) TestIds() {
+	user := NewUserService(time.Second, s.users, nil, NewRoleService(time.Second, nil, 0))
+
+	var errRequired *entity.ErrNoRequiredParameter
+	_, _, err := user.BatchGet(internalCall(), nil)
+	s.ErrorAs(err, &errRequired)
+
+	var errValidation *entity.ErrValidation
+	_, _, err = user.BatchGet(internalCall(), make([]string, maxBatchGetIds+1))
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "ids")
+
+	// ids that are not uuids are missing without a query
+	s.users.queried = nil
+	_, missing, err := user.BatchGet(internalCall(), []string{"first", "second"})
+	s.NoError(err)
+	s.Equal([]string{"first", "second"}, missing)
+	s.Nil(s.users.queried)
+}
+
+func TestBatchGetTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchGetTestSuite))
+}
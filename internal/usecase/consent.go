@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ConsentServiceName = "consentService"
+	ConsentSpanName    = "consentUsecase"
+)
+
+// contentHash is a hex encoded sha256
+var contentHash = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+type ConsentStorageI interface {
+	PublishDocument(ctx context.Context, document *entity.ConsentDocument) error
+	GetDocument(ctx context.Context, documentType string, version uint64) (*entity.ConsentDocument, error)
+	Record(ctx context.Context, consent *entity.Consent) error
+	Withdraw(ctx context.Context, userId, documentType string) (*entity.ConsentWithdrawn, error)
+	List(ctx context.Context, userId string) ([]*entity.Consent, error)
+}
+
+// consentFields are the audited fields of a consent
+var consentFields = map[string]func(*entity.Consent) string{
+	"document_type":    func(c *entity.Consent) string { return c.DocumentType },
+	"document_version": func(c *entity.Consent) string { return formatVersion(c.DocumentVersion) },
+}
+
+func formatVersion(version uint64) string {
+	if version == 0 {
+		return ""
+	}
+	return strconv.FormatUint(version, 10)
+}
+
+type consentService struct {
+	repo       repository.UserStorageI
+	audit      auditLog
+	authz      Authorizer
+	ctxTimeout time.Duration
+}
+
+// NewConsentService records what each user agreed to and when, users give and withdraw their own
+// consents and those of their dependants, admins publish documents with consents.write
+func NewConsentService(ctxTimeout time.Duration, repo repository.UserStorageI, audit repository.AuditStorageI, authz Authorizer) consentService {
+	return consentService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		audit:      auditLog{repo: audit},
+		authz:      authz,
+	}
+}
+
+func validateConsentType(documentType string) error {
+	for _, t := range entity.ConsentTypes {
+		if t == documentType {
+			return nil
+		}
+	}
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = fmt.Errorf("invalid document type %q", documentType)
+	errValidation.Errors["document_type"] = "document_type must be one of " + strings.Join(entity.ConsentTypes, ", ")
+	return errValidation
+}
+
+// PublishDocument makes the next version of document.Type the current one, users who signed up
+// earlier keep their consent to the version they accepted
+func (c consentService) PublishDocument(ctx context.Context, document *entity.ConsentDocument) error {
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ConsentServiceName, ConsentSpanName+"PublishDocument")
+	defer span.End()
+
+	if err := c.authz.Authorize(ctx, entity.PermissionConsentsWrite); err != nil {
+		return err
+	}
+	if err := validateConsentType(document.Type); err != nil {
+		return err
+	}
+	if document.Url == "" {
+		return entity.NewErrNoRequiredParameter("url")
+	}
+	if !contentHash.MatchString(document.ContentHash) {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid content hash")
+		errValidation.Errors["content_hash"] = "content_hash must be the hex encoded sha256 of the document"
+		return errValidation
+	}
+
+	document.PublishedBy = reqinfo.From(ctx).ActorId
+	return c.repo.PublishConsentDocument(ctx, document)
+}
+
+// GetDocument returns a version of a document, the current one when version is 0, documents are public
+func (c consentService) GetDocument(ctx context.Context, documentType string, version uint64) (*entity.ConsentDocument, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ConsentServiceName, ConsentSpanName+"GetDocument")
+	defer span.End()
+
+	if err := validateConsentType(documentType); err != nil {
+		return nil, err
+	}
+
+	return c.repo.GetConsentDocument(ctx, documentType, version)
+}
+
+// Record stores the consent of consent.UserId to a version of a document, the current one when
+// consent.DocumentVersion is 0
+func (c consentService) Record(ctx context.Context, consent *entity.Consent) error {
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ConsentServiceName, ConsentSpanName+"Record")
+	defer span.End()
+
+	if err := authorizeOwner(ctx, c.authz, c.repo, consent.UserId, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+	if err := validateConsentType(consent.DocumentType); err != nil {
+		return err
+	}
+
+	document, err := c.repo.GetConsentDocument(ctx, consent.DocumentType, consent.DocumentVersion)
+	if err != nil {
+		return err
+	}
+	if _, err := c.repo.Get(ctx, map[string]string{"id": consent.UserId}); err != nil {
+		return err
+	}
+
+	consent.Id = uuid.New().String()
+	consent.DocumentVersion = document.Version
+	consent.IP = reqinfo.From(ctx).IP
+	if err := c.repo.CreateConsent(ctx, consent); err != nil {
+		return err
+	}
+	c.audit.record(ctx, entity.AuditEntityUser, consent.UserId, entity.AuditActionRecordConsent,
+		auditChanges(fieldNames(consentFields), consentFields, &entity.Consent{}, consent))
+
+	return nil
+}
+
+// Withdraw withdraws every standing consent of a user to a document type, the terms can not be
+// withdrawn, a user who no longer accepts them erases the account. Withdrawing again returns the
+// last withdrawal, so a caller that failed to send its event retries
+func (c consentService) Withdraw(ctx context.Context, userId, documentType string) (*entity.ConsentWithdrawn, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ConsentServiceName, ConsentSpanName+"Withdraw")
+	defer span.End()
+
+	if err := authorizeOwner(ctx, c.authz, c.repo, userId, entity.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+	if err := validateConsentType(documentType); err != nil {
+		return nil, err
+	}
+	if documentType == entity.ConsentTypeTerms {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("the terms can not be withdrawn")
+		errValidation.Errors["document_type"] = "the terms can not be withdrawn, erase the account instead"
+		return nil, errValidation
+	}
+
+	consents, err := c.repo.WithdrawConsent(ctx, userId, documentType)
+	var errNotFound *entity.ErrNotFound
+	if errors.As(err, &errNotFound) {
+		return c.lastWithdrawal(ctx, userId, documentType, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	withdrawn := &entity.ConsentWithdrawn{UserId: userId, DocumentType: documentType}
+	for _, consent := range consents {
+		if consent.DocumentVersion > withdrawn.DocumentVersion {
+			withdrawn.DocumentVersion = consent.DocumentVersion
+			withdrawn.WithdrawnAt = consent.WithdrawnAt
+		}
+	}
+	c.audit.record(ctx, entity.AuditEntityUser, userId, entity.AuditActionWithdrawConsent,
+		auditChanges(fieldNames(consentFields), consentFields, &entity.Consent{
+			DocumentType:    documentType,
+			DocumentVersion: withdrawn.DocumentVersion,
+		}, &entity.Consent{}))
+
+	return withdrawn, nil
+}
+
+// lastWithdrawal returns the withdrawal of the newest consent of a user to a document type,
+// errNotFound when that consent still stands or the user never gave one
+func (c consentService) lastWithdrawal(ctx context.Context, userId, documentType string, errNotFound error) (*entity.ConsentWithdrawn, error) {
+	consents, err := c.repo.ListConsents(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	for _, consent := range consents {
+		if consent.DocumentType != documentType {
+			continue
+		}
+		if consent.WithdrawnAt.IsZero() {
+			break
+		}
+		return &entity.ConsentWithdrawn{
+			UserId:          userId,
+			DocumentType:    documentType,
+			DocumentVersion: consent.DocumentVersion,
+			WithdrawnAt:     consent.WithdrawnAt,
+		}, nil
+	}
+	return nil, errNotFound
+}
+
+// List returns every consent a user gave, withdrawn ones included, the newest first
+func (c consentService) List(ctx context.Context, userId string) ([]*entity.Consent, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ConsentServiceName, ConsentSpanName+"List")
+	defer span.End()
+
+	if err := authorizeOwner(ctx, c.authz, c.repo, userId, entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	return c.repo.ListConsents(ctx, userId)
+}
+
+// acceptTerms requires a signup to accept the current terms and adds the consent to the user,
+// so Create stores both together
+func (u userService) acceptTerms(ctx context.Context, user *entity.User) error {
+	terms, err := u.repo.GetConsentDocument(ctx, entity.ConsentTypeTerms, 0)
+	if err != nil {
+		return err
+	}
+	if user.AcceptedTermsVersion != terms.Version {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = fmt.Errorf("accepted terms version %d, current is %d", user.AcceptedTermsVersion, terms.Version)
+		errValidation.Errors["accepted_terms_version"] = fmt.Sprintf("accepted_terms_version must be the current terms version %d", terms.Version)
+		return errValidation
+	}
+
+	user.Consents = []*entity.Consent{{
+		Id:              uuid.New().String(),
+		UserId:          user.Id,
+		DocumentType:    entity.ConsentTypeTerms,
+		DocumentVersion: terms.Version,
+		IP:              reqinfo.From(ctx).IP,
+	}}
+	return nil
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ConsentTestSuite struct {
+	suite.Suite
+	repo    *consentStorageStub
+	audit   *auditStorageStub
+	consent consentService
+	user    userService
+}
+
+// consentStorageStub serves version 2 of every document and keeps the consents of the user of userStorageStub
+type consentStorageStub struct {
+	*userStorageStub
+	consents []*entity.Consent
+	created  *entity.User
+}
+
+func (c *consentStorageStub) Create(_ context.Context, user *entity.User) error {
+	c.created = user
+	return nil
+}
+
+func (c *consentStorageStub) GetDependant(context.Context, string) (*entity.Dependant, error) {
+	return nil, entity.NewErrNotFound("dependant")
+}
+
+func (c *consentStorageStub) GetConsentDocument(_ context.Context, documentType string, version uint64) (*entity.ConsentDocument, error) {
+	if version > 2 {
+		return nil, entity.NewErrNotFound("consent document")
+	}
+	if version == 0 {
+		version = 2
+	}
+	return &entity.ConsentDocument{Type: documentType, Version: version}, nil
+}
+
+func (c *consentStorageStub) CreateConsent(_ context.Context, consent *entity.Consent) error {
+	consent.AcceptedAt = time.Now()
+	c.consents = append(c.consents, consent)
+	return nil
+}
+
+func (c *consentStorageStub) WithdrawConsent(_ context.Context, userId, documentType string) ([]*entity.Consent, error) {
+	var withdrawn []*entity.Consent
+	for _, consent := range c.consents {
+		if consent.UserId == userId && consent.DocumentType == documentType && consent.WithdrawnAt.IsZero() {
+			consent.WithdrawnAt = time.Now()
+			withdrawn = append(withdrawn, consent)
+		}
+	}
+	if len(withdrawn) == 0 {
+		return nil, entity.NewErrNotFound("consent")
+	}
+	return withdrawn, nil
+}
+
+func (c *consentStorageStub) ListConsents(_ context.Context, userId string) ([]*entity.Consent, error) {
+	var consents []*entity.Consent
+	for i := len(c.consents) - 1; i >= 0; i-- {
+		if c.consents[i].UserId == userId {
+			consents = append(consents, c.consents[i])
+		}
+	}
+	return consents, nil
+}
+
+func (s *ConsentTestSuite) SetupTest() {
+	s.repo = &consentStorageStub{
+		userStorageStub: &userStorageStub{user: &entity.User{Id: "patient", Status: entity.UserStatusActive, Version: 1}},
+	}
+	s.audit = &auditStorageStub{}
+	authz := NewRoleService(time.Second, nil, 0)
+	s.consent = NewConsentService(time.Second, s.repo, s.audit, authz)
+	s.user = NewUserService(time.Second, s.repo, s.audit, authz)
+}
+
+func (s *ConsentTestSuite) TestSignupAcceptsCurrentTerms() {
+	user := &entity.User{Id: "patient", PhoneNumber: "+998901234567", Password: "secret"}
+
+	var errValidation *entity.ErrValidation
+	_, err := s.user.Create(internalCall(), user)
+	s.ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "accepted_terms_version")
+
+	user.AcceptedTermsVersion = 1
+	_, err = s.user.Create(internalCall(), user)
+	s.ErrorAs(err, &errValidation)
+
+	ctx := reqinfo.With(context.Background(), reqinfo.Info{IP: "10.0.0.1"})
+	user.AcceptedTermsVersion = 2
+	_, err = s.user.Create(ctx, user)
+	s.Require().NoError(err)
+	s.Require().Len(s.repo.created.Consents, 1)
+	s.Equal(entity.ConsentTypeTerms, s.repo.created.Consents[0].DocumentType)
+	s.Equal(uint64(2), s.repo.created.Consents[0].DocumentVersion)
+	s.Equal("10.0.0.1", s.repo.created.Consents[0].IP)
+}
+
+func (s *ConsentTestSuite) TestSelfSignup() {
+	user := &entity.User{
+		Id:                   "chosen",
+		PhoneNumber:          "+998901234567",
+		Password:             "secret",
+		RefreshToken:         "forged",
+		Status:               entity.UserStatusPending,
+		AcceptedTermsVersion: 2,
+	}
+
+	// a client signing itself up has no actor yet
+	id, err := s.user.Create(reqinfo.With(context.Background(), reqinfo.Info{IP: "10.0.0.1"}), user)
+	s.Require().NoError(err)
+	s.NotEqual("chosen", id)
+	s.Equal(id, s.repo.created.Id)
+	s.Empty(s.repo.created.RefreshToken)
+	s.Equal(entity.UserStatusActive, s.repo.created.Status)
+
+	// a user can not create accounts for others
+	var errDenied *entity.ErrPermissionDenied
+	_, err = s.user.Create(actingUser("patient"), &entity.User{PhoneNumber: "+998901234568", Password: "secret", AcceptedTermsVersion: 2})
+	s.ErrorAs(err, &errDenied)
+
+	// internal callers keep the id they chose
+	user = &entity.User{Id: "imported", PhoneNumber: "+998901234569", Password: "secret", AcceptedTermsVersion: 2}
+	id, err = s.user.Create(internalCall(), user)
+	s.Require().NoError(err)
+	s.Equal("imported", id)
+}
+
+func (s *ConsentTestSuite) TestRecordAndWithdraw() {
+	consent := &entity.Consent{UserId: "patient", DocumentType: entity.ConsentTypeDataSharing}
+	s.Require().NoError(s.consent.Record(actingUser("patient"), consent))
+	s.NotEmpty(consent.Id)
+	s.Equal(uint64(2), consent.DocumentVersion)
+	s.Equal(entity.AuditActionRecordConsent, s.audit.entries[0].Action)
+
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(s.consent.Record(actingUser("stranger"), &entity.Consent{UserId: "patient", DocumentType: entity.ConsentTypePrivacy}), &errDenied)
+
+	var errNotFound *entity.ErrNotFound
+	s.ErrorAs(s.consent.Record(actingUser("patient"), &entity.Consent{UserId: "patient", DocumentType: entity.ConsentTypePrivacy, DocumentVersion: 3}), &errNotFound)
+
+	var errValidation *entity.ErrValidation
+	_, err := s.consent.Withdraw(actingUser("patient"), "patient", entity.ConsentTypeTerms)
+	s.ErrorAs(err, &errValidation)
+
+	withdrawn, err := s.consent.Withdraw(actingUser("patient"), "patient", entity.ConsentTypeDataSharing)
+	s.Require().NoError(err)
+	s.Equal(uint64(2), withdrawn.DocumentVersion)
+	s.False(withdrawn.WithdrawnAt.IsZero())
+	s.Equal(entity.AuditActionWithdrawConsent, s.audit.entries[1].Action)
+
+	// withdrawing again returns the same withdrawal for its event to be sent again, without auditing it twice
+	again, err := s.consent.Withdraw(actingUser("patient"), "patient", entity.ConsentTypeDataSharing)
+	s.Require().NoError(err)
+	s.Equal(withdrawn, again)
+	s.Len(s.audit.entries, 2)
+
+	// there is nothing to withdraw
+	_, err = s.consent.Withdraw(actingUser("patient"), "patient", entity.ConsentTypePrivacy)
+	s.ErrorAs(err, &errNotFound)
+}
+
+func (s *ConsentTestSuite) TestPublishDocument() {
+	document := &entity.ConsentDocument{Type: entity.ConsentTypeTerms, Url: "https://dennic.uz/terms"}
+
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(s.consent.PublishDocument(actingUser("patient"), document), &errDenied)
+
+	var errValidation *entity.ErrValidation
+	s.ErrorAs(s.consent.PublishDocument(internalCall(), document), &errValidation)
+}
+
+func TestConsentTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentTestSuite))
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// dependantFields are the audited fields of a guardianship
+var dependantFields = map[string]func(*entity.Dependant) string{
+	"guardian_id":  func(d *entity.Dependant) string { return d.GuardianId },
+	"relationship": func(d *entity.Dependant) string { return d.Relationship },
+}
+
+// authorizeUser lets a user act on its own account and on the accounts of its dependants,
+// anyone else needs permission
+func (u userService) authorizeUser(ctx context.Context, id, permission string) error {
+	return authorizeOwner(ctx, u.authz, u.repo, id, permission)
+}
+
+// authorizeOwner lets a user act on data of its own account and of the accounts of its dependants,
+// anyone else needs permission
+func authorizeOwner(ctx context.Context, authz Authorizer, users repository.UserStorageI, id, permission string) error {
+	info := reqinfo.From(ctx)
+	if info.ActorType == entity.ActorTypeUser && info.ActorId != "" && info.ActorId != id {
+		dependant, err := users.GetDependant(ctx, id)
+		var errNotFound *entity.ErrNotFound
+		switch {
+		case err == nil && dependant.GuardianId == info.ActorId:
+			return nil
+		case err != nil && !errors.As(err, &errNotFound):
+			return err
+		}
+	}
+	return authorizeSelf(ctx, authz, entity.ActorTypeUser, id, permission)
+}
+
+func validateRelationship(relationship string) error {
+	for _, r := range entity.DependantRelationships {
+		if r == relationship {
+			return nil
+		}
+	}
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = fmt.Errorf("invalid relationship %q", relationship)
+	errValidation.Errors["relationship"] = "relationship must be child, parent, spouse, sibling or other"
+	return errValidation
+}
+
+// AddDependant creates a user without credentials under the guardian dependant.GuardianId,
+// a dependant can not log in and is managed by its guardian
+func (u userService) AddDependant(ctx context.Context, dependant *entity.Dependant) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"AddDependant")
+	defer span.End()
+
+	if err := authorizeSelf(ctx, u.authz, entity.ActorTypeUser, dependant.GuardianId, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+
+	user := dependant.User
+	if user == nil || user.FirstName == "" || user.LastName == "" || user.BirthDate == "" || user.Gender == "" {
+		return entity.NewErrNoRequiredParameter("first_name", "last_name", "birth_date", "gender")
+	}
+	if err := validateRelationship(dependant.Relationship); err != nil {
+		return err
+	}
+	if err := validateProfile(user); err != nil {
+		return err
+	}
+
+	user.Id = uuid.New().String()
+	user.PhoneNumber = ""
+	user.Password = ""
+	user.RefreshToken = ""
+	user.Status = entity.UserStatusActive
+	user.CreatedAt = time.Now()
+	if err := u.repo.CreateDependant(ctx, dependant); err != nil {
+		return err
+	}
+	created, err := u.repo.GetDependant(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+	*dependant = *created
+
+	changes := auditChanges(fieldNames(userAuditFields), userAuditFields, &entity.User{}, dependant.User)
+	for field, change := range auditChanges(fieldNames(dependantFields), dependantFields, &entity.Dependant{}, dependant) {
+		changes[field] = change
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, dependant.User.Id, entity.AuditActionAddDependant, changes)
+
+	return nil
+}
+
+func (u userService) ListDependants(ctx context.Context, guardianId string) ([]*entity.Dependant, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ListDependants")
+	defer span.End()
+
+	if err := authorizeSelf(ctx, u.authz, entity.ActorTypeUser, guardianId, entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListDependants(ctx, guardianId)
+}
+
+// TransferDependant hands a dependant over to the guardian guardianId, an empty relationship keeps
+// the current one, only the current guardian or an admin may transfer
+func (u userService) TransferDependant(ctx context.Context, dependantId, guardianId, relationship string) (*entity.Dependant, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"TransferDependant")
+	defer span.End()
+
+	if dependantId == "" || guardianId == "" {
+		return nil, entity.NewErrNoRequiredParameter("dependant_id", "guardian_id")
+	}
+
+	dependant, err := u.repo.GetDependant(ctx, dependantId)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeSelf(ctx, u.authz, entity.ActorTypeUser, dependant.GuardianId, entity.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+	before := *dependant
+
+	if relationship != "" {
+		if err := validateRelationship(relationship); err != nil {
+			return nil, err
+		}
+		dependant.Relationship = relationship
+	}
+	if guardianId == dependantId {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("a dependant can not be its own guardian")
+		errValidation.Errors["guardian_id"] = "guardian_id must be another user"
+		return nil, errValidation
+	}
+	dependant.GuardianId = guardianId
+
+	if err := u.repo.TransferDependant(ctx, dependant); err != nil {
+		return nil, err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, dependantId, entity.AuditActionTransferDependant,
+		auditChanges(fieldNames(dependantFields), dependantFields, &before, dependant))
+
+	return dependant, nil
+}
+
+// CheckGuardianship tells other services whether guardianId may act on behalf of dependantId,
+// e.g. to book an appointment, and returns the relationship when it may
+func (u userService) CheckGuardianship(ctx context.Context, guardianId, dependantId string) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"CheckGuardianship")
+	defer span.End()
+
+	if err := authorizeSelf(ctx, u.authz, entity.ActorTypeUser, guardianId, entity.PermissionUsersRead); err != nil {
+		return false, "", err
+	}
+
+	dependant, err := u.repo.GetDependant(ctx, dependantId)
+	if err != nil {
+		var errNotFound *entity.ErrNotFound
+		if errors.As(err, &errNotFound) {
+			return false, "", nil
+		}
+		return false, "", err
+	}
+	if dependant.GuardianId != guardianId {
+		return false, "", nil
+	}
+
+	return true, dependant.Relationship, nil
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DependantTestSuite struct {
+	suite.Suite
+	repo  *dependantStorageStub
+	audit *auditStorageStub
+	user  userService
+}
+
+// dependantStorageStub keeps dependants by id next to the single user of userStorageStub
+type dependantStorageStub struct {
+	*userStorageStub
+	dependants map[string]*entity.Dependant
+}
+
+func (d *dependantStorageStub) CreateDependant(_ context.Context, dependant *entity.Dependant) error {
+	d.dependants[dependant.User.Id] = dependant
+	return nil
+}
+
+func (d *dependantStorageStub) GetDependant(_ context.Context, id string) (*entity.Dependant, error) {
+	dependant, ok := d.dependants[id]
+	if !ok {
+		return nil, entity.NewErrNotFound("dependant")
+	}
+	copied := *dependant
+	return &copied, nil
+}
+
+func (d *dependantStorageStub) ListEmergencyContacts(context.Context, string) ([]*entity.EmergencyContact, error) {
+	return nil, nil
+}
+
+func (d *dependantStorageStub) TransferDependant(_ context.Context, dependant *entity.Dependant) error {
+	d.dependants[dependant.User.Id] = dependant
+	return nil
+}
+
+func (s *DependantTestSuite) SetupTest() {
+	s.repo = &dependantStorageStub{
+		userStorageStub: &userStorageStub{user: &entity.User{Id: "child", Status: entity.UserStatusActive, Version: 1}},
+		dependants:      make(map[string]*entity.Dependant),
+	}
+	s.audit = &auditStorageStub{}
+	s.user = NewUserService(time.Second, s.repo, s.audit, NewRoleService(time.Second, nil, 0))
+}
+
+func actingUser(id string) context.Context {
+	return reqinfo.With(context.Background(), reqinfo.Info{ActorType: entity.ActorTypeUser, ActorId: id})
+}
+
+func (s *DependantTestSuite) TestAddDependant() {
+	dependant := &entity.Dependant{
+		GuardianId:   "parent",
+		Relationship: entity.DependantRelationshipChild,
+		User: &entity.User{
+			FirstName:   "Ali",
+			LastName:    "Valiyev",
+			BirthDate:   "2018-05-01",
+			Gender:      "male",
+			PhoneNumber: "+998901234567",
+			Password:    "secret",
+		},
+	}
+	s.Require().NoError(s.user.AddDependant(actingUser("parent"), dependant))
+	s.NotEmpty(dependant.User.Id)
+	s.Empty(dependant.User.PhoneNumber)
+	s.Empty(dependant.User.Password)
+	s.Equal(entity.AuditActionAddDependant, s.audit.entries[0].Action)
+	s.Equal("parent", s.audit.entries[0].Changes["guardian_id"].After)
+
+	// only the guardian itself may add dependants to its account
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(s.user.AddDependant(actingUser("stranger"), &entity.Dependant{GuardianId: "parent"}), &errDenied)
+
+	var errValidation *entity.ErrValidation
+	dependant.Relationship = "cousin"
+	s.ErrorAs(s.user.AddDependant(actingUser("parent"), dependant), &errValidation)
+}
+
+func (s *DependantTestSuite) TestGuardianActsOnBehalf() {
+	s.repo.dependants["child"] = &entity.Dependant{User: s.repo.user, GuardianId: "parent", Relationship: entity.DependantRelationshipChild}
+
+	user, err := s.user.Get(actingUser("parent"), map[string]string{"id": "child"})
+	s.Require().NoError(err)
+	s.Equal("child", user.Id)
+
+	var errDenied *entity.ErrPermissionDenied
+	_, err = s.user.Get(actingUser("stranger"), map[string]string{"id": "child"})
+	s.ErrorAs(err, &errDenied)
+
+	isGuardian, relationship, err := s.user.CheckGuardianship(internalCall(), "parent", "child")
+	s.Require().NoError(err)
+	s.True(isGuardian)
+	s.Equal(entity.DependantRelationshipChild, relationship)
+
+	isGuardian, _, err = s.user.CheckGuardianship(internalCall(), "stranger", "child")
+	s.Require().NoError(err)
+	s.False(isGuardian)
+}
+
+func (s *DependantTestSuite) TestTransferDependant() {
+	s.repo.dependants["child"] = &entity.Dependant{User: s.repo.user, GuardianId: "parent", Relationship: entity.DependantRelationshipChild}
+
+	var errDenied *entity.ErrPermissionDenied
+	_, err := s.user.TransferDependant(actingUser("stranger"), "child", "stranger", "")
+	s.ErrorAs(err, &errDenied)
+
+	dependant, err := s.user.TransferDependant(actingUser("parent"), "child", "grandparent", "")
+	s.Require().NoError(err)
+	s.Equal("grandparent", dependant.GuardianId)
+	s.Equal(entity.DependantRelationshipChild, dependant.Relationship)
+	s.Equal(entity.AuditActionTransferDependant, s.audit.entries[0].Action)
+	s.Equal(entity.AuditChange{Before: "parent", After: "grandparent"}, s.audit.entries[0].Changes["guardian_id"])
+
+	// the previous guardian lost access
+	s.ErrorAs(s.user.authorizeUser(actingUser("parent"), "child", entity.PermissionUsersRead), &errDenied)
+}
+
+func TestDependantTestSuite(t *testing.T) {
+	suite.Run(t, new(DependantTestSuite))
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EmailVerificationServiceName = "emailVerificationService"
+	EmailVerificationSpanName    = "emailVerificationUsecase"
+)
+
+type EmailVerificationStorageI interface {
+	Request(ctx context.Context, userId string) (*entity.EmailVerification, error)
+	Verify(ctx context.Context, token string) (string, error)
+}
+
+// EmailVerificationNotifier delivers the verification token to the email being verified
+type EmailVerificationNotifier interface {
+	SendEmailVerification(ctx context.Context, verification *entity.EmailVerification, token string) error
+}
+
+type emailVerificationService struct {
+	repo       repository.UserStorageI
+	audit      auditLog
+	authz      Authorizer
+	notifier   EmailVerificationNotifier
+	ttl        time.Duration
+	ctxTimeout time.Duration
+}
+
+// NewEmailVerificationService sends verification tokens valid for ttl, unlike invites the token is never
+// handed to the requester, which would not prove the user owns the email, so a notifier is required
+func NewEmailVerificationService(ctxTimeout time.Duration, repo repository.UserStorageI, audit repository.AuditStorageI,
+	authz Authorizer, notifier EmailVerificationNotifier, ttl time.Duration) emailVerificationService {
+	return emailVerificationService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		audit:      auditLog{repo: audit},
+		authz:      authz,
+		notifier:   notifier,
+		ttl:        ttl,
+	}
+}
+
+// Request sends a single-use token to the unverified email of a user
+func (e emailVerificationService) Request(ctx context.Context, userId string) (*entity.EmailVerification, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, EmailVerificationServiceName, EmailVerificationSpanName+"Request")
+	defer span.End()
+
+	if err := authorizeSelf(ctx, e.authz, entity.ActorTypeUser, userId, entity.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+	if e.notifier == nil {
+		return nil, errors.New("email verification is not available, no notifier is configured")
+	}
+
+	user, err := e.repo.Get(ctx, map[string]string{"id": userId})
+	if err != nil {
+		return nil, err
+	}
+	if user.Email == "" {
+		return nil, entity.NewErrNoRequiredParameter("email")
+	}
+	if !user.EmailVerifiedAt.IsZero() {
+		return nil, entity.NewErrConflict("verified email")
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	verification := &entity.EmailVerification{
+		Id:        uuid.New().String(),
+		UserId:    userId,
+		Email:     user.Email,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(e.ttl),
+	}
+	if err := e.repo.CreateEmailVerification(ctx, verification); err != nil {
+		return nil, err
+	}
+	if err := e.notifier.SendEmailVerification(ctx, verification, token); err != nil {
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+// Verify marks the email the token was sent to verified and returns the id of its user
+func (e emailVerificationService) Verify(ctx context.Context, token string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, EmailVerificationServiceName, EmailVerificationSpanName+"Verify")
+	defer span.End()
+
+	if token == "" {
+		return "", entity.NewErrNoRequiredParameter("token")
+	}
+
+	userId, err := e.repo.VerifyEmail(ctx, hashToken(token))
+	if err != nil {
+		return "", err
+	}
+	e.audit.record(ctx, entity.AuditEntityUser, userId, entity.AuditActionVerifyEmail, nil)
+
+	return userId, nil
+}
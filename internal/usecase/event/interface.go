@@ -9,6 +9,8 @@ type ConsumerConfig interface {
 	GetBrokers() []string
 	GetTopic() string
 	GetGroupID() string
+	// GetBroadcast is true when every replica reads every message from the time it starts
+	GetBroadcast() bool
 	GetHandler() func(ctx context.Context, key, value []byte) error
 }
 
@@ -20,5 +22,9 @@ type BrokerConsumer interface {
 
 type BrokerProducer interface {
 	ProduceContent(ctx context.Context, key string, value *entity.User) error
+	ProduceUserErased(ctx context.Context, value *entity.UserErased) error
+	ProduceConsentWithdrawn(ctx context.Context, value *entity.ConsentWithdrawn) error
+	ProduceCacheInvalidated(ctx context.Context, value *entity.CacheInvalidated) error
+	ProduceUserMerged(ctx context.Context, value *entity.UserMerged) error
 	Close()
 }
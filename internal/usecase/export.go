@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ExportServiceName = "exportService"
+	ExportSpanName    = "exportUsecase"
+)
+
+type ExportStorageI interface {
+	ExportUsers(ctx context.Context, export *entity.Export, w io.Writer) error
+	ExportAdmins(ctx context.Context, export *entity.Export, w io.Writer) error
+}
+
+type exportService struct {
+	users      repository.UserStorageI
+	admins     repository.AdminStorageI
+	authz      Authorizer
+	ctxTimeout time.Duration
+}
+
+// NewExportService writes users and admins as they are listed to CSV or NDJSON, an export reads
+// its rows through a cursor and may run for the whole ctxTimeout
+func NewExportService(ctxTimeout time.Duration, users repository.UserStorageI, admins repository.AdminStorageI, authz Authorizer) exportService {
+	return exportService{
+		ctxTimeout: ctxTimeout,
+		users:      users,
+		admins:     admins,
+		authz:      authz,
+	}
+}
+
+// ExportUsers writes the live users matching the filters of listing to w, users.read is required
+func (e exportService) ExportUsers(ctx context.Context, export *entity.Export, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, e.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ExportServiceName, ExportSpanName+"ExportUsers")
+	defer span.End()
+
+	if err := e.authz.Authorize(ctx, entity.PermissionUsersRead); err != nil {
+		return err
+	}
+
+	return writeExport(export, entity.UserExportColumns, w, func(columns []string, fn func([]any) error) error {
+		return e.users.Export(ctx, columns, export.Filter, fn)
+	})
+}
+
+// ExportAdmins writes the active admins matching the filters of listing to w, admins.read is required
+func (e exportService) ExportAdmins(ctx context.Context, export *entity.Export, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, e.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, ExportServiceName, ExportSpanName+"ExportAdmins")
+	defer span.End()
+
+	if err := e.authz.Authorize(ctx, entity.PermissionAdminsRead); err != nil {
+		return err
+	}
+
+	return writeExport(export, entity.AdminExportColumns, w, func(columns []string, fn func([]any) error) error {
+		return e.admins.Export(ctx, columns, export.Filter, fn)
+	})
+}
+
+// writeExport validates the format and columns of export against the exportable columns and writes
+// every row read to w
+func writeExport(export *entity.Export, exportable []string, w io.Writer, read func([]string, func([]any) error) error) error {
+	columns, err := exportColumns(export, exportable)
+	if err != nil {
+		return err
+	}
+
+	switch export.Format {
+	case "", entity.ExportFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(columns); err != nil {
+			return err
+		}
+		record := make([]string, len(columns))
+		err = read(columns, func(values []any) error {
+			for i, value := range values {
+				record[i] = formatExportValue(value)
+			}
+			return writer.Write(record)
+		})
+		if err != nil {
+			return err
+		}
+		writer.Flush()
+		return writer.Error()
+	default:
+		var line []byte
+		return read(columns, func(values []any) error {
+			line = append(line[:0], '{')
+			for i, value := range values {
+				if i != 0 {
+					line = append(line, ',')
+				}
+				line = strconv.AppendQuote(line, columns[i])
+				line = append(line, ':')
+				if t, ok := value.(time.Time); ok {
+					value = formatExportValue(t)
+				}
+				encoded, err := json.Marshal(value)
+				if err != nil {
+					return err
+				}
+				line = append(line, encoded...)
+			}
+			line = append(line, '}', '\n')
+			_, err := w.Write(line)
+			return err
+		})
+	}
+}
+
+// exportColumns returns the columns an export selects, all exportable columns when it names none
+func exportColumns(export *entity.Export, exportable []string) ([]string, error) {
+	errValidation := entity.NewErrValidation()
+	if export.Format != "" && export.Format != entity.ExportFormatCSV && export.Format != entity.ExportFormatNDJSON {
+		errValidation.Errors["format"] = fmt.Sprintf("format must be %s or %s", entity.ExportFormatCSV, entity.ExportFormatNDJSON)
+	}
+
+	allowed := make(map[string]bool, len(exportable))
+	for _, column := range exportable {
+		allowed[column] = true
+	}
+	var unknown, repeated []string
+	selected := make(map[string]bool, len(export.Columns))
+	for _, column := range export.Columns {
+		switch {
+		case !allowed[column]:
+			unknown = append(unknown, column)
+		case selected[column]:
+			repeated = append(repeated, column)
+		}
+		selected[column] = true
+	}
+	if len(unknown) != 0 {
+		errValidation.Errors["columns"] = "columns can not be exported: " + strings.Join(unknown, ", ")
+	} else if len(repeated) != 0 {
+		errValidation.Errors["columns"] = "columns are repeated: " + strings.Join(repeated, ", ")
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = errors.New("invalid export")
+		return nil, errValidation
+	}
+	if len(export.Columns) == 0 {
+		return exportable, nil
+	}
+	return export.Columns, nil
+}
+
+// formatExportValue is the text of a value in a CSV, times are RFC 3339 in UTC and NULL is empty
+func formatExportValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ExportTestSuite struct {
+	suite.Suite
+	users  *exportUserStub
+	admins *exportAdminStub
+	export exportService
+}
+
+// exportUserStub returns its rows cut to the columns asked for and remembers the filter
+type exportUserStub struct {
+	repository.UserStorageI
+	rows   []map[string]any
+	filter map[string]string
+}
+
+func (e *exportUserStub) Export(_ context.Context, columns []string, filter map[string]string, fn func([]any) error) error {
+	e.filter = filter
+	return exportRows(e.rows, columns, fn)
+}
+
+type exportAdminStub struct {
+	repository.AdminStorageI
+	rows []map[string]any
+}
+
+func (e *exportAdminStub) Export(_ context.Context, columns []string, _ map[string]string, fn func([]any) error) error {
+	return exportRows(e.rows, columns, fn)
+}
+
+func exportRows(rows []map[string]any, columns []string, fn func([]any) error) error {
+	for _, row := range rows {
+		values := make([]any, len(columns))
+		for i, column := range columns {
+			values[i] = row[column]
+		}
+		if err := fn(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ExportTestSuite) SetupTest() {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.users = &exportUserStub{rows: []map[string]any{
+		{"id": "first", "user_order": int32(1), "first_name": "Ali, Vali", "email": nil, "created_at": createdAt},
+		{"id": "second", "user_order": int32(2), "first_name": "Olim", "email": "olim@dennic.uz", "created_at": createdAt},
+	}}
+	s.admins = &exportAdminStub{rows: []map[string]any{
+		{"id": "admin", "salary": float64(1500.5), "terminated_at": nil},
+	}}
+	s.export = NewExportService(time.Second, s.users, s.admins, NewRoleService(time.Second, nil, 0))
+}
+
+func (s *ExportTestSuite) TestCSV() {
+	var buf bytes.Buffer
+	err := s.export.ExportUsers(internalCall(), &entity.Export{
+		Columns: []string{"id", "first_name", "email", "created_at"},
+		Filter:  map[string]string{"city": "Tashkent"},
+	}, &buf)
+	s.Require().NoError(err)
+	s.Equal("id,first_name,email,created_at\n"+
+		"first,\"Ali, Vali\",,2024-01-02T03:04:05Z\n"+
+		"second,Olim,olim@dennic.uz,2024-01-02T03:04:05Z\n", buf.String())
+	s.Equal("Tashkent", s.users.filter["city"])
+
+	buf.Reset()
+	s.Require().NoError(s.export.ExportAdmins(internalCall(), &entity.Export{}, &buf))
+	s.Contains(buf.String(), "id,admin_order,role,")
+	s.NotContains(buf.String(), "password")
+	s.Contains(buf.String(), "admin,,,")
+}
+
+func (s *ExportTestSuite) TestNDJSON() {
+	var buf bytes.Buffer
+	err := s.export.ExportUsers(internalCall(), &entity.Export{
+		Format:  entity.ExportFormatNDJSON,
+		Columns: []string{"user_order", "email", "created_at"},
+	}, &buf)
+	s.Require().NoError(err)
+	s.Equal(`{"user_order":1,"email":null,"created_at":"2024-01-02T03:04:05Z"}`+"\n"+
+		`{"user_order":2,"email":"olim@dennic.uz","created_at":"2024-01-02T03:04:05Z"}`+"\n", buf.String())
+
+	buf.Reset()
+	err = s.export.ExportAdmins(internalCall(), &entity.Export{
+		Format:  entity.ExportFormatNDJSON,
+		Columns: []string{"salary", "terminated_at"},
+	}, &buf)
+	s.Require().NoError(err)
+	s.Equal(`{"salary":1500.5,"terminated_at":null}`+"\n", buf.String())
+}
+
+func (s *ExportTestSuite) TestValidation() {
+	var errValidation *entity.ErrValidation
+	err := s.export.ExportUsers(internalCall(), &entity.Export{
+		Format:  "xlsx",
+		Columns: []string{"id", "password", "refresh_token"},
+	}, &bytes.Buffer{})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "format")
+	s.Contains(errValidation.Errors["columns"], "password, refresh_token")
+
+	err = s.export.ExportAdmins(internalCall(), &entity.Export{Columns: []string{"id", "id"}}, &bytes.Buffer{})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors["columns"], "repeated")
+
+	var errDenied *entity.ErrPermissionDenied
+	s.ErrorAs(s.export.ExportUsers(actingUser("patient"), &entity.Export{}, &bytes.Buffer{}), &errDenied)
+}
+
+func TestExportTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportTestSuite))
+}
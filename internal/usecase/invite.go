@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	InviteServiceName = "inviteService"
+	InviteSpanName    = "inviteUsecase"
+)
+
+type InviteStorageI interface {
+	Invite(ctx context.Context, admin *entity.Admin, channel string) (*entity.AdminInvite, string, error)
+	Accept(ctx context.Context, token, password string) (string, error)
+	List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AdminInvite, error)
+	Revoke(ctx context.Context, id string) error
+}
+
+// InviteNotifier delivers the invite token to the invited admin
+type InviteNotifier interface {
+	SendInvite(ctx context.Context, invite *entity.AdminInvite, token string) error
+}
+
+type inviteService struct {
+	repo       repository.AdminStorageI
+	audit      auditLog
+	authz      Authorizer
+	notifier   InviteNotifier
+	ttl        time.Duration
+	ctxTimeout time.Duration
+}
+
+// NewInviteService creates invites valid for ttl, a nil notifier leaves delivering the token to the inviter
+func NewInviteService(ctxTimeout time.Duration, repo repository.AdminStorageI, audit repository.AuditStorageI,
+	authz Authorizer, notifier InviteNotifier, ttl time.Duration) inviteService {
+	return inviteService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		audit:      auditLog{repo: audit},
+		authz:      authz,
+		notifier:   notifier,
+		ttl:        ttl,
+	}
+}
+
+// Invite creates a pending admin without a password and sends it a single-use token,
+// the token is returned for the inviter to hand over when the invite was not delivered
+func (i inviteService) Invite(ctx context.Context, admin *entity.Admin, channel string) (*entity.AdminInvite, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, InviteServiceName, InviteSpanName+"Invite")
+	defer span.End()
+
+	// the invite sets the role of the admin, which grants permissions
+	for _, permission := range []string{entity.PermissionAdminsWrite, entity.PermissionRolesWrite} {
+		if err := i.authz.Authorize(ctx, permission); err != nil {
+			return nil, "", err
+		}
+	}
+
+	invite := &entity.AdminInvite{
+		Id:        uuid.New().String(),
+		AdminId:   admin.Id,
+		Channel:   channel,
+		InvitedBy: reqinfo.From(ctx).ActorId,
+		ExpiresAt: time.Now().Add(i.ttl),
+	}
+	if invite.AdminId == "" {
+		invite.AdminId = uuid.New().String()
+		admin.Id = invite.AdminId
+	}
+
+	var recipientField string
+	switch channel {
+	case "", entity.InviteChannelEmail:
+		invite.Channel = entity.InviteChannelEmail
+		invite.Recipient = admin.Email
+		recipientField = "email"
+	case entity.InviteChannelSMS:
+		invite.Recipient = admin.PhoneNumber
+		recipientField = "phone_number"
+	default:
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid invite channel")
+		errValidation.Errors["channel"] = "channel must be email or sms"
+		return nil, "", errValidation
+	}
+	if invite.Recipient == "" {
+		return nil, "", entity.NewErrNoRequiredParameter(recipientField)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, "", err
+	}
+	invite.TokenHash = hashToken(token)
+
+	admin.Password = ""
+	if admin.CreatedAt.IsZero() {
+		admin.CreatedAt = time.Now()
+	}
+	if err := setWorkYears(admin, time.Now()); err != nil {
+		return nil, "", err
+	}
+	if err := i.repo.CreateInvite(ctx, admin, invite); err != nil {
+		return nil, "", err
+	}
+	i.audit.record(ctx, entity.AuditEntityAdmin, admin.Id, entity.AuditActionInvite,
+		auditChanges(fieldNames(adminAuditFields), adminAuditFields, &entity.Admin{}, admin))
+
+	if i.notifier == nil {
+		return invite, token, nil
+	}
+	if err := i.notifier.SendInvite(ctx, invite, token); err != nil {
+		trace.SpanFromContext(ctx).RecordError(err)
+		return invite, token, nil
+	}
+	invite.Notified = true
+
+	return invite, "", nil
+}
+
+// Accept sets the password of the admin invited with token and activates it
+func (i inviteService) Accept(ctx context.Context, token, password string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, InviteServiceName, InviteSpanName+"Accept")
+	defer span.End()
+
+	if token == "" || password == "" {
+		return "", entity.NewErrNoRequiredParameter("token", "password")
+	}
+
+	adminId, err := i.repo.AcceptInvite(ctx, hashToken(token), password)
+	if err != nil {
+		return "", err
+	}
+	i.audit.record(ctx, entity.AuditEntityAdmin, adminId, entity.AuditActionAcceptInvite,
+		map[string]entity.AuditChange{"password": secretChange()})
+
+	return adminId, nil
+}
+
+func (i inviteService) List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.AdminInvite, error) {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, InviteServiceName, InviteSpanName+"List")
+	defer span.End()
+
+	if err := i.authz.Authorize(ctx, entity.PermissionAdminsRead); err != nil {
+		return nil, err
+	}
+
+	return i.repo.ListInvites(ctx, limit, offset, filter)
+}
+
+// Revoke cancels an invite that was not accepted and removes its pending admin
+func (i inviteService) Revoke(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, i.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, InviteServiceName, InviteSpanName+"Revoke")
+	defer span.End()
+
+	if err := i.authz.Authorize(ctx, entity.PermissionAdminsWrite); err != nil {
+		return err
+	}
+
+	adminId, err := i.repo.RevokeInvite(ctx, id)
+	if err != nil {
+		return err
+	}
+	i.audit.record(ctx, entity.AuditEntityAdmin, adminId, entity.AuditActionRevokeInvite, nil)
+
+	return nil
+}
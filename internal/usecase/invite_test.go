@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type InviteTestSuite struct {
+	suite.Suite
+	repo     *adminStorageStub
+	notifier *inviteNotifierStub
+}
+
+// adminStorageStub keeps the created invite and accepts its token hash
+type adminStorageStub struct {
+	repository.AdminStorageI
+	admin  *entity.Admin
+	invite *entity.AdminInvite
+}
+
+func (a *adminStorageStub) CreateInvite(_ context.Context, admin *entity.Admin, invite *entity.AdminInvite) error {
+	a.admin, a.invite = admin, invite
+	return nil
+}
+
+func (a *adminStorageStub) AcceptInvite(_ context.Context, tokenHash, _ string) (string, error) {
+	if a.invite == nil || a.invite.TokenHash != tokenHash {
+		return "", entity.NewErrNotFound("pending invite")
+	}
+	return a.invite.AdminId, nil
+}
+
+type inviteNotifierStub struct {
+	token string
+	err   error
+}
+
+func (n *inviteNotifierStub) SendInvite(_ context.Context, _ *entity.AdminInvite, token string) error {
+	n.token = token
+	return n.err
+}
+
+func (s *InviteTestSuite) SetupTest() {
+	s.repo = &adminStorageStub{}
+	s.notifier = &inviteNotifierStub{}
+}
+
+func (s *InviteTestSuite) service(notifier InviteNotifier) inviteService {
+	return NewInviteService(time.Second, s.repo, nil, NewRoleService(time.Second, nil, 0), notifier, time.Hour)
+}
+
+func (s *InviteTestSuite) TestInviteNotified() {
+	admin := &entity.Admin{Email: "admin@dennic.uz", Password: "chosen by inviter"}
+
+	invite, token, err := s.service(s.notifier).Invite(internalCall(), admin, "")
+	s.Require().NoError(err)
+	s.True(invite.Notified)
+	s.Empty(token)
+	s.Empty(s.repo.admin.Password)
+	s.Equal(entity.InviteChannelEmail, invite.Channel)
+	s.Equal(admin.Email, invite.Recipient)
+	s.Equal(admin.Id, invite.AdminId)
+	s.Equal(entity.InviteStatusPending, invite.Status(time.Now()))
+
+	// only the hash is stored, the token reached the notifier
+	s.NotEqual(s.notifier.token, s.repo.invite.TokenHash)
+	s.Equal(hashToken(s.notifier.token), s.repo.invite.TokenHash)
+
+	adminId, err := s.service(s.notifier).Accept(internalCall(), s.notifier.token, "password")
+	s.Require().NoError(err)
+	s.Equal(admin.Id, adminId)
+}
+
+func (s *InviteTestSuite) TestInviteNotDelivered() {
+	s.notifier.err = errors.New("unavailable")
+
+	for _, notifier := range []InviteNotifier{nil, s.notifier} {
+		invite, token, err := s.service(notifier).Invite(internalCall(), &entity.Admin{PhoneNumber: "+998901234567"}, entity.InviteChannelSMS)
+		s.Require().NoError(err)
+		s.False(invite.Notified)
+		s.Equal(hashToken(token), s.repo.invite.TokenHash)
+	}
+}
+
+func (s *InviteTestSuite) TestInviteValidation() {
+	_, _, err := s.service(nil).Invite(internalCall(), &entity.Admin{Email: "admin@dennic.uz"}, "pigeon")
+	var errValidation *entity.ErrValidation
+	s.ErrorAs(err, &errValidation)
+
+	_, _, err = s.service(nil).Invite(internalCall(), &entity.Admin{Email: "admin@dennic.uz"}, entity.InviteChannelSMS)
+	var errNoRequired *entity.ErrNoRequiredParameter
+	s.ErrorAs(err, &errNoRequired)
+}
+
+func (s *InviteTestSuite) TestAcceptUnknownToken() {
+	_, err := s.service(nil).Accept(internalCall(), "unknown", "password")
+	var errNotFound *entity.ErrNotFound
+	s.ErrorAs(err, &errNotFound)
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
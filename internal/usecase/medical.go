@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MedicalServiceName = "medicalService"
+	MedicalSpanName    = "medicalUsecase"
+)
+
+// maxMedicalEntries bounds each list of a medical profile
+const maxMedicalEntries = 50
+
+type MedicalStorageI interface {
+	Get(ctx context.Context, userId string) (*entity.MedicalProfile, error)
+	Update(ctx context.Context, profile *entity.MedicalProfile) error
+}
+
+// medicalFields are the audited fields of a medical profile, auditSecretFields masks their values
+var medicalFields = map[string]func(*entity.MedicalProfile) string{
+	"blood_type":         func(m *entity.MedicalProfile) string { return m.BloodType },
+	"allergies":          func(m *entity.MedicalProfile) string { return strings.Join(m.Allergies, "\n") },
+	"chronic_conditions": func(m *entity.MedicalProfile) string { return strings.Join(m.ChronicConditions, "\n") },
+	"medications":        func(m *entity.MedicalProfile) string { return strings.Join(m.Medications, "\n") },
+}
+
+type medicalService struct {
+	repo       repository.MedicalStorageI
+	users      repository.UserStorageI
+	audit      auditLog
+	authz      Authorizer
+	ctxTimeout time.Duration
+}
+
+// NewMedicalService lets patients and their guardians read and write their own medical profile,
+// admins need medical.read or medical.write, users.read and users.write do not grant it
+func NewMedicalService(ctxTimeout time.Duration, repo repository.MedicalStorageI, users repository.UserStorageI,
+	audit repository.AuditStorageI, authz Authorizer) medicalService {
+	return medicalService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		users:      users,
+		audit:      auditLog{repo: audit},
+		authz:      authz,
+	}
+}
+
+func (m medicalService) Get(ctx context.Context, userId string) (*entity.MedicalProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, MedicalServiceName, MedicalSpanName+"Get")
+	defer span.End()
+
+	if err := authorizeOwner(ctx, m.authz, m.users, userId, entity.PermissionMedicalRead); err != nil {
+		return nil, err
+	}
+
+	return m.repo.Get(ctx, userId)
+}
+
+// Update replaces the medical profile of profile.UserId, a Version of 0 creates it
+func (m medicalService) Update(ctx context.Context, profile *entity.MedicalProfile) error {
+	ctx, cancel := context.WithTimeout(ctx, m.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, MedicalServiceName, MedicalSpanName+"Update")
+	defer span.End()
+
+	if err := authorizeOwner(ctx, m.authz, m.users, profile.UserId, entity.PermissionMedicalWrite); err != nil {
+		return err
+	}
+	if err := validateMedicalProfile(profile); err != nil {
+		return err
+	}
+
+	if _, err := m.users.Get(ctx, map[string]string{"id": profile.UserId}); err != nil {
+		return err
+	}
+	before := &entity.MedicalProfile{}
+	if profile.Version != 0 {
+		current, err := m.repo.Get(ctx, profile.UserId)
+		if err != nil {
+			return err
+		}
+		before = current
+	}
+
+	profile.UpdatedBy = reqinfo.From(ctx).ActorId
+	if err := m.repo.Save(ctx, profile); err != nil {
+		return err
+	}
+	m.audit.record(ctx, entity.AuditEntityUser, profile.UserId, entity.AuditActionUpdateMedical,
+		auditChanges(fieldNames(medicalFields), medicalFields, before, profile))
+
+	return nil
+}
+
+func validateMedicalProfile(profile *entity.MedicalProfile) error {
+	errValidation := entity.NewErrValidation()
+
+	if profile.BloodType != "" {
+		valid := false
+		for _, bloodType := range entity.BloodTypes {
+			if bloodType == profile.BloodType {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			errValidation.Errors["blood_type"] = "blood_type must be one of " + strings.Join(entity.BloodTypes, ", ")
+		}
+	}
+	for field, list := range map[string][]string{
+		"allergies":          profile.Allergies,
+		"chronic_conditions": profile.ChronicConditions,
+		"medications":        profile.Medications,
+	} {
+		if len(list) > maxMedicalEntries {
+			errValidation.Errors[field] = fmt.Sprintf("%s may have at most %d entries", field, maxMedicalEntries)
+			continue
+		}
+		for _, entry := range list {
+			if strings.TrimSpace(entry) == "" {
+				errValidation.Errors[field] = field + " must not have empty entries"
+				break
+			}
+		}
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = errors.New("invalid medical profile")
+		return errValidation
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MedicalTestSuite struct {
+	suite.Suite
+	repo    *medicalStorageStub
+	audit   *auditStorageStub
+	medical medicalService
+}
+
+// medicalStorageStub keeps profiles by user id in clear text
+type medicalStorageStub struct {
+	repository.MedicalStorageI
+	profiles map[string]*entity.MedicalProfile
+}
+
+func (m *medicalStorageStub) Get(_ context.Context, userId string) (*entity.MedicalProfile, error) {
+	profile, ok := m.profiles[userId]
+	if !ok {
+		return nil, entity.NewErrNotFound("medical profile")
+	}
+	copied := *profile
+	return &copied, nil
+}
+
+func (m *medicalStorageStub) Save(_ context.Context, profile *entity.MedicalProfile) error {
+	current, ok := m.profiles[profile.UserId]
+	if profile.Version == 0 && ok || profile.Version != 0 && (!ok || current.Version != profile.Version) {
+		return entity.NewErrPreconditionFailed("medical profile")
+	}
+	profile.Version++
+	copied := *profile
+	m.profiles[profile.UserId] = &copied
+	return nil
+}
+
+func (s *MedicalTestSuite) SetupTest() {
+	s.repo = &medicalStorageStub{profiles: make(map[string]*entity.MedicalProfile)}
+	s.audit = &auditStorageStub{}
+	users := &dependantStorageStub{
+		userStorageStub: &userStorageStub{user: &entity.User{Id: "child", Status: entity.UserStatusActive, Version: 1}},
+		dependants:      make(map[string]*entity.Dependant),
+	}
+	users.dependants["child"] = &entity.Dependant{User: users.user, GuardianId: "parent", Relationship: entity.DependantRelationshipChild}
+	authz := NewRoleService(time.Second, &roleStorageStub{permissions: map[string][]string{
+		"receptionist": {entity.PermissionUsersRead, entity.PermissionUsersWrite},
+		"doctor":       {entity.PermissionMedicalRead},
+	}}, 0)
+	s.medical = NewMedicalService(time.Second, s.repo, users, s.audit, authz)
+}
+
+func (s *MedicalTestSuite) TestUpdate() {
+	profile := &entity.MedicalProfile{
+		UserId:    "child",
+		BloodType: "AB+",
+		Allergies: []string{"penicillin"},
+	}
+	s.Require().NoError(s.medical.Update(actingUser("child"), profile))
+	s.Equal(uint64(1), profile.Version)
+	s.Equal("child", profile.UpdatedBy)
+
+	// the audit log shows what changed, never the values
+	s.Equal(entity.AuditActionUpdateMedical, s.audit.entries[0].Action)
+	s.Equal(entity.AuditChange{After: maskedValue}, s.audit.entries[0].Changes["blood_type"])
+	s.NotContains(s.audit.entries[0].Changes, "medications")
+
+	// the guardian keeps the profile of its dependant
+	profile.Medications = []string{"ibuprofen"}
+	s.Require().NoError(s.medical.Update(actingUser("parent"), profile))
+	s.Equal(uint64(2), profile.Version)
+	s.Equal("parent", profile.UpdatedBy)
+
+	var errPrecondition *entity.ErrPreconditionFailed
+	profile.Version = 1
+	s.ErrorAs(s.medical.Update(actingUser("child"), profile), &errPrecondition)
+
+	var errValidation *entity.ErrValidation
+	s.ErrorAs(s.medical.Update(actingUser("child"), &entity.MedicalProfile{UserId: "child", BloodType: "C"}), &errValidation)
+	s.ErrorAs(s.medical.Update(actingUser("child"), &entity.MedicalProfile{UserId: "child", Allergies: []string{" "}}), &errValidation)
+}
+
+func (s *MedicalTestSuite) TestAccess() {
+	s.repo.profiles["child"] = &entity.MedicalProfile{UserId: "child", BloodType: "O-", Version: 1}
+
+	profile, err := s.medical.Get(actingUser("parent"), "child")
+	s.Require().NoError(err)
+	s.Equal("O-", profile.BloodType)
+
+	profile, err = s.medical.Get(actor(entity.ActorTypeAdmin, "doctor"), "child")
+	s.Require().NoError(err)
+	s.Equal("O-", profile.BloodType)
+
+	// users.read and users.write do not reach medical data
+	var errDenied *entity.ErrPermissionDenied
+	_, err = s.medical.Get(actingUser("stranger"), "child")
+	s.ErrorAs(err, &errDenied)
+	_, err = s.medical.Get(actor(entity.ActorTypeAdmin, "receptionist"), "child")
+	s.ErrorAs(err, &errDenied)
+	s.ErrorAs(s.medical.Update(actor(entity.ActorTypeAdmin, "receptionist"), profile), &errDenied)
+	s.ErrorAs(s.medical.Update(actor(entity.ActorTypeAdmin, "doctor"), profile), &errDenied)
+}
+
+func TestMedicalTestSuite(t *testing.T) {
+	suite.Run(t, new(MedicalTestSuite))
+}
@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RoleServiceName = "roleService"
+	RoleSpanName    = "roleUsecase"
+)
+
+type RoleStorageI interface {
+	Authorizer
+	Create(ctx context.Context, role *entity.Role) (string, error)
+	Get(ctx context.Context, id string) (*entity.Role, error)
+	List(ctx context.Context, limit, offset uint64) ([]*entity.Role, error)
+	Update(ctx context.Context, role *entity.Role) error
+	Delete(ctx context.Context, id string) error
+	Assign(ctx context.Context, adminId, roleId string) error
+	Revoke(ctx context.Context, adminId, roleId string) error
+	ListAdminRoles(ctx context.Context, adminId string) ([]*entity.Role, error)
+	ListAssignmentHistory(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.RoleAssignment, error)
+}
+
+type roleService struct {
+	repo       repository.RoleStorageI
+	cache      *permissionCache
+	ctxTimeout time.Duration
+}
+
+// NewRoleService caches the resolved permissions of an admin for cacheTTL, changes made through
+// this service invalidate the cache right away, other instances see them after cacheTTL
+func NewRoleService(ctxTimeout time.Duration, repo repository.RoleStorageI, cacheTTL time.Duration) roleService {
+	return roleService{
+		ctxTimeout: ctxTimeout,
+		repo:       repo,
+		cache:      newPermissionCache(cacheTTL),
+	}
+}
+
+// Authorize checks that the actor of ctx holds permission, only contexts marked reqinfo.Internal
+// such as kafka consumers and jobs may go without an actor, users hold no admin permission
+func (r roleService) Authorize(ctx context.Context, permission string) error {
+	info := reqinfo.From(ctx)
+	switch info.ActorType {
+	case "":
+		if !info.Internal {
+			return denied(permission)
+		}
+		return nil
+	case entity.ActorTypeAdmin:
+	default:
+		return denied(permission)
+	}
+
+	permissions, ok := r.cache.get(info.ActorId)
+	if !ok {
+		ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+		defer cancel()
+
+		ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Authorize")
+		defer span.End()
+
+		granted, err := r.repo.AdminPermissions(ctx, info.ActorId)
+		if err != nil {
+			return err
+		}
+		permissions = make(map[string]bool, len(granted))
+		for _, p := range granted {
+			permissions[p] = true
+		}
+		r.cache.set(info.ActorId, permissions)
+	}
+
+	if !hasPermission(permissions, permission) {
+		return denied(permission)
+	}
+	return nil
+}
+
+func (r roleService) Invalidate(adminId string) {
+	r.cache.invalidate(adminId)
+}
+
+func (r roleService) Create(ctx context.Context, role *entity.Role) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Create")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesWrite); err != nil {
+		return "", err
+	}
+	if err := validateRole(role); err != nil {
+		return "", err
+	}
+	if role.Id == "" {
+		role.Id = uuid.New().String()
+	}
+
+	if err := r.repo.Create(ctx, role); err != nil {
+		return "", err
+	}
+
+	return role.Id, nil
+}
+
+func (r roleService) Get(ctx context.Context, id string) (*entity.Role, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Get")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesRead); err != nil {
+		return nil, err
+	}
+
+	return r.repo.Get(ctx, id)
+}
+
+func (r roleService) List(ctx context.Context, limit, offset uint64) ([]*entity.Role, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"List")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesRead); err != nil {
+		return nil, err
+	}
+
+	return r.repo.List(ctx, limit, offset)
+}
+
+// Update replaces a role, built-in roles can not be changed
+func (r roleService) Update(ctx context.Context, role *entity.Role) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Update")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesWrite); err != nil {
+		return err
+	}
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	if err := r.repo.Update(ctx, role); err != nil {
+		return err
+	}
+	r.cache.invalidate("")
+
+	return nil
+}
+
+// Delete removes a role and its assignments, built-in roles can not be deleted
+func (r roleService) Delete(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Delete")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesWrite); err != nil {
+		return err
+	}
+
+	if err := r.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.cache.invalidate("")
+
+	return nil
+}
+
+func (r roleService) Assign(ctx context.Context, adminId, roleId string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Assign")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesWrite); err != nil {
+		return err
+	}
+
+	if err := r.repo.Assign(ctx, adminId, roleId, reqinfo.From(ctx).ActorId); err != nil {
+		return err
+	}
+	r.cache.invalidate(adminId)
+
+	return nil
+}
+
+func (r roleService) Revoke(ctx context.Context, adminId, roleId string) error {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"Revoke")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesWrite); err != nil {
+		return err
+	}
+
+	if err := r.repo.Revoke(ctx, adminId, roleId, reqinfo.From(ctx).ActorId); err != nil {
+		return err
+	}
+	r.cache.invalidate(adminId)
+
+	return nil
+}
+
+// ListAdminRoles returns the roles assigned to an admin, an admin may list its own roles
+func (r roleService) ListAdminRoles(ctx context.Context, adminId string) ([]*entity.Role, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"ListAdminRoles")
+	defer span.End()
+
+	if err := authorizeSelf(ctx, r, entity.ActorTypeAdmin, adminId, entity.PermissionRolesRead); err != nil {
+		return nil, err
+	}
+
+	return r.repo.ListAdminRoles(ctx, adminId)
+}
+
+func (r roleService) ListAssignmentHistory(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.RoleAssignment, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, RoleServiceName, RoleSpanName+"ListAssignmentHistory")
+	defer span.End()
+
+	if err := r.Authorize(ctx, entity.PermissionRolesRead); err != nil {
+		return nil, err
+	}
+
+	return r.repo.ListAssignmentHistory(ctx, limit, offset, filter)
+}
+
+// validateRole requires a name and known permissions
+func validateRole(role *entity.Role) error {
+	errValidation := entity.NewErrValidation()
+	if role.Name == "" {
+		errValidation.Errors["name"] = "name is required"
+	}
+
+	known := make(map[string]bool, len(entity.Permissions))
+	for _, permission := range entity.Permissions {
+		known[permission] = true
+	}
+	for _, permission := range role.Permissions {
+		if !known[permission] {
+			errValidation.Errors["permissions"] = "unknown permission " + permission
+		}
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = errors.New("invalid role")
+		return errValidation
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"dennic_user_service/internal/entity"
+	"fmt"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// parseDate accepts the dates clients send and the timestamps dates are read back as
+func parseDate(date string) (time.Time, error) {
+	if t, err := time.Parse(dateLayout, date); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, date)
+}
+
+// workYears counts the full years from start to end, to now while the employment lasts
+func workYears(start, end time.Time, now time.Time) uint64 {
+	if end.IsZero() {
+		end = now
+	}
+	years := end.Year() - start.Year()
+	if end.Month() < start.Month() || end.Month() == start.Month() && end.Day() < start.Day() {
+		years--
+	}
+	if years < 0 {
+		return 0
+	}
+	return uint64(years)
+}
+
+// setWorkYears derives admin.WorkYears from its employment dates, clients never set it
+func setWorkYears(admin *entity.Admin, now time.Time) error {
+	if admin.StartWorkYear == "" {
+		admin.WorkYears = 0
+		return nil
+	}
+
+	errValidation := entity.NewErrValidation()
+	start, err := parseDate(admin.StartWorkYear)
+	if err != nil {
+		errValidation.Errors["start_work_year"] = "date must be formatted as " + dateLayout
+	}
+	var end time.Time
+	if admin.EndWorkYear != "" {
+		if end, err = parseDate(admin.EndWorkYear); err != nil {
+			errValidation.Errors["end_work_year"] = "date must be formatted as " + dateLayout
+		} else if end.Before(start) {
+			errValidation.Errors["end_work_year"] = "end_work_year is before start_work_year"
+		}
+	}
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid employment dates %q - %q", admin.StartWorkYear, admin.EndWorkYear)
+		return errValidation
+	}
+
+	admin.WorkYears = workYears(start, end, now)
+	return nil
+}
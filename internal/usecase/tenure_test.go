@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"dennic_user_service/internal/entity"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TenureTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (s *TenureTestSuite) SetupTest() {
+	s.now = time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *TenureTestSuite) TestWorkYearsWhileEmployed() {
+	admin := &entity.Admin{StartWorkYear: "2020-03-16", WorkYears: 99}
+
+	s.Require().NoError(setWorkYears(admin, s.now))
+	s.Equal(uint64(3), admin.WorkYears)
+
+	admin.StartWorkYear = "2020-03-15"
+	s.Require().NoError(setWorkYears(admin, s.now))
+	s.Equal(uint64(4), admin.WorkYears)
+}
+
+func (s *TenureTestSuite) TestWorkYearsAfterTermination() {
+	// dates read back from the database are timestamps
+	admin := &entity.Admin{StartWorkYear: "2010-09-01T00:00:00Z", EndWorkYear: "2015-08-31"}
+
+	s.Require().NoError(setWorkYears(admin, s.now))
+	s.Equal(uint64(4), admin.WorkYears)
+}
+
+func (s *TenureTestSuite) TestInvalidDates() {
+	var errValidation *entity.ErrValidation
+
+	err := setWorkYears(&entity.Admin{StartWorkYear: "first of may"}, s.now)
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "start_work_year")
+
+	err = setWorkYears(&entity.Admin{StartWorkYear: "2020-01-01", EndWorkYear: "2019-01-01"}, s.now)
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "end_work_year")
+}
+
+func (s *TenureTestSuite) TestWorkYearsIsNotUpdatable() {
+	_, err := updateMask([]string{"work_years"}, adminUpdateFields, &entity.Admin{WorkYears: 5})
+
+	var errValidation *entity.ErrValidation
+	s.ErrorAs(err, &errValidation)
+}
+
+func TestTenureTestSuite(t *testing.T) {
+	suite.Run(t, new(TenureTestSuite))
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"dennic_user_service/internal/entity"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// userUpdateFields lists the user fields Update may change with their value,
+// an empty value means the field is not set, which decides what an empty update mask writes
+var userUpdateFields = map[string]func(*entity.User) string{
+	"first_name":         func(u *entity.User) string { return u.FirstName },
+	"last_name":          func(u *entity.User) string { return u.LastName },
+	"birth_date":         func(u *entity.User) string { return u.BirthDate },
+	"phone_number":       func(u *entity.User) string { return u.PhoneNumber },
+	"gender":             func(u *entity.User) string { return u.Gender },
+	"email":              func(u *entity.User) string { return u.Email },
+	"preferred_language": func(u *entity.User) string { return u.PreferredLanguage },
+	"address_line":       func(u *entity.User) string { return u.AddressLine },
+	"city":               func(u *entity.User) string { return u.City },
+	"country":            func(u *entity.User) string { return u.Country },
+	"avatar_key":         func(u *entity.User) string { return u.AvatarKey },
+}
+
+var adminUpdateFields = map[string]func(*entity.Admin) string{
+	"role":            func(a *entity.Admin) string { return a.Role },
+	"first_name":      func(a *entity.Admin) string { return a.FirstName },
+	"last_name":       func(a *entity.Admin) string { return a.LastName },
+	"birth_date":      func(a *entity.Admin) string { return a.BirthDate },
+	"phone_number":    func(a *entity.Admin) string { return a.PhoneNumber },
+	"email":           func(a *entity.Admin) string { return a.Email },
+	"gender":          func(a *entity.Admin) string { return a.Gender },
+	"salary":          func(a *entity.Admin) string { return formatNonZero(float64(a.Salary)) },
+	"biography":       func(a *entity.Admin) string { return a.Biography },
+	"start_work_year": func(a *entity.Admin) string { return a.StartWorkYear },
+	"end_work_year":   func(a *entity.Admin) string { return a.EndWorkYear },
+}
+
+// userAuditFields adds the password, which is only set on create, to the fields clients update
+var userAuditFields = func() map[string]func(*entity.User) string {
+	fields := map[string]func(*entity.User) string{
+		"password": func(u *entity.User) string { return u.Password },
+	}
+	for field, value := range userUpdateFields {
+		fields[field] = value
+	}
+	return fields
+}()
+
+// adminAuditFields adds the password and the fields derived by the service to the ones clients update
+var adminAuditFields = func() map[string]func(*entity.Admin) string {
+	fields := map[string]func(*entity.Admin) string{
+		"password":   func(a *entity.Admin) string { return a.Password },
+		"work_years": func(a *entity.Admin) string { return formatNonZero(float64(a.WorkYears)) },
+	}
+	for field, value := range adminUpdateFields {
+		fields[field] = value
+	}
+	return fields
+}()
+
+// passwordUpdateReason is why Update rejects a password, ChangePassword checks the current one first
+const passwordUpdateReason = "password is changed with ChangePassword"
+
+// passwordUpdateError rejects an update that sets a password
+func passwordUpdateError() error {
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = errors.New("password can not be updated")
+	errValidation.Errors["password"] = passwordUpdateReason
+	return errValidation
+}
+
+func formatNonZero(value float64) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// updateMask validates the paths of an update mask against the updatable fields,
+// an empty mask selects every field set on item
+func updateMask[T any](paths []string, updatable map[string]func(T) string, item T) ([]string, error) {
+	if len(paths) == 0 {
+		for field, value := range updatable {
+			if value(item) != "" {
+				paths = append(paths, field)
+			}
+		}
+		sort.Strings(paths)
+	}
+
+	errValidation := entity.NewErrValidation()
+	for _, path := range paths {
+		if _, ok := updatable[path]; !ok {
+			errValidation.Errors[path] = "field can not be updated"
+		}
+		if path == "password" {
+			errValidation.Errors[path] = passwordUpdateReason
+		}
+	}
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = fmt.Errorf("invalid update mask %v", paths)
+		return nil, errValidation
+	}
+
+	if len(paths) == 0 {
+		errValidation.Err = errors.New("nothing to update")
+		errValidation.Errors["update_mask"] = "no field is set"
+		return nil, errValidation
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"dennic_user_service/internal/entity"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UpdateMaskTestSuite struct {
+	suite.Suite
+}
+
+func (s *UpdateMaskTestSuite) TestExplicitPaths() {
+	user := &entity.User{FirstName: "John", Password: "secret"}
+
+	fields, err := updateMask([]string{"first_name"}, userUpdateFields, user)
+	s.Require().NoError(err)
+	s.Equal([]string{"first_name"}, fields)
+}
+
+func (s *UpdateMaskTestSuite) TestEmptyMaskSelectsSetFields() {
+	admin := &entity.Admin{FirstName: "John", Salary: 100}
+
+	fields, err := updateMask(nil, adminUpdateFields, admin)
+	s.Require().NoError(err)
+	s.Equal([]string{"first_name", "salary"}, fields)
+}
+
+func (s *UpdateMaskTestSuite) TestUnknownPath() {
+	_, err := updateMask([]string{"first_name", "refresh_token"}, userUpdateFields, &entity.User{})
+
+	var errValidation *entity.ErrValidation
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "refresh_token")
+	s.NotContains(errValidation.Errors, "first_name")
+}
+
+func (s *UpdateMaskTestSuite) TestPasswordPath() {
+	var errValidation *entity.ErrValidation
+	_, err := updateMask([]string{"first_name", "password"}, userUpdateFields, &entity.User{})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Equal(passwordUpdateReason, errValidation.Errors["password"])
+
+	_, err = updateMask([]string{"password"}, adminUpdateFields, &entity.Admin{})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Equal(passwordUpdateReason, errValidation.Errors["password"])
+
+	// an empty mask never selects the password
+	fields, err := updateMask(nil, userUpdateFields, &entity.User{FirstName: "John", Password: "secret"})
+	s.Require().NoError(err)
+	s.Equal([]string{"first_name"}, fields)
+}
+
+func (s *UpdateMaskTestSuite) TestNothingToUpdate() {
+	_, err := updateMask(nil, userUpdateFields, &entity.User{})
+
+	var errValidation *entity.ErrValidation
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "update_mask")
+}
+
+func TestUpdateMaskTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateMaskTestSuite))
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 	"dennic_user_service/internal/entity"
 	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/metrics"
 	"dennic_user_service/internal/pkg/otlp"
+	"dennic_user_service/internal/pkg/reqinfo"
+	"io"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
@@ -17,26 +22,50 @@ type UserStorageI interface {
 	Create(ctx context.Context, user *entity.User) (string, error)
 	Get(ctx context.Context, params map[string]string) (*entity.User, error)
 	List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error)
-	Update(ctx context.Context, kyc *entity.User) error
+	Update(ctx context.Context, kyc *entity.User, fields []string) error
 	Delete(ctx context.Context, id string) error
 	CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error)
 	IfExists(ctx context.Context, req *entity.IfExistsReq) (*entity.IfExistsResp, error)
 	ChangePassword(ctx context.Context, req *entity.ChangeUserPasswordReq) (*entity.ChangePasswordResp, error)
 	UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error)
+	ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	ExportData(ctx context.Context, id string) (*entity.UserDataExport, error)
+	Erase(ctx context.Context, id string) (*entity.UserErased, error)
+	ChangeStatus(ctx context.Context, id, status, reason string, expiresAt time.Time) (*entity.User, error)
+	AddDependant(ctx context.Context, dependant *entity.Dependant) error
+	ListDependants(ctx context.Context, guardianId string) ([]*entity.Dependant, error)
+	TransferDependant(ctx context.Context, dependantId, guardianId, relationship string) (*entity.Dependant, error)
+	CheckGuardianship(ctx context.Context, guardianId, dependantId string) (bool, string, error)
+	AddEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) error
+	ListEmergencyContacts(ctx context.Context, userId string) ([]*entity.EmergencyContact, error)
+	UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) error
+	DeleteEmergencyContact(ctx context.Context, userId, id string) error
+	ImportUsers(ctx context.Context, r io.Reader, dryRun bool) (*entity.UserImportReport, error)
+	BatchGet(ctx context.Context, ids []string) ([]*entity.User, []string, error)
 }
 
 type userService struct {
 	repo       repository.UserStorageI
+	audit      auditLog
+	authz      Authorizer
 	ctxTimeout time.Duration
 }
 
-func NewUserService(ctxTimeout time.Duration, repo repository.UserStorageI) userService {
+// NewUserService records every mutation in audit, a nil audit storage disables the audit log,
+// authz checks the permissions of admins, users may only act on their own account and their dependants
+func NewUserService(ctxTimeout time.Duration, repo repository.UserStorageI, audit repository.AuditStorageI, authz Authorizer) userService {
 	return userService{
 		ctxTimeout: ctxTimeout,
 		repo:       repo,
+		audit:      auditLog{repo: audit},
+		authz:      authz,
 	}
 }
 
+// Create signs a user up. A request without an actor is a self-signup: the user gets a new id, the
+// default status and no session whatever it asked for, creating users on behalf of others needs users.write
 func (u userService) Create(ctx context.Context, user *entity.User) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
@@ -44,7 +73,36 @@ func (u userService) Create(ctx context.Context, user *entity.User) (string, err
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Create")
 	defer span.End()
 
-	return user.Id, u.repo.Create(ctx, user)
+	if info := reqinfo.From(ctx); info.ActorType == "" && !info.Internal {
+		user.Id = uuid.New().String()
+		user.RefreshToken = ""
+		user.Status = ""
+	} else if err := u.authz.Authorize(ctx, entity.PermissionUsersWrite); err != nil {
+		return "", err
+	}
+	// only dependants, created with AddDependant, have no credentials
+	if user.PhoneNumber == "" || user.Password == "" {
+		return "", entity.NewErrNoRequiredParameter("phone_number", "password")
+	}
+	if err := validateSignupStatus(user); err != nil {
+		return "", err
+	}
+	if err := validateProfile(user); err != nil {
+		return "", err
+	}
+	if err := u.acceptTerms(ctx, user); err != nil {
+		return "", err
+	}
+
+	if err := u.repo.Create(ctx, user); err != nil {
+		return "", err
+	}
+	metrics.UserSignups.Inc()
+
+	u.audit.record(ctx, entity.AuditEntityUser, user.Id, entity.AuditActionCreate,
+		auditChanges(fieldNames(userAuditFields), userAuditFields, &entity.User{}, user))
+
+	return user.Id, nil
 }
 
 func (u userService) Get(ctx context.Context, params map[string]string) (*entity.User, error) {
@@ -54,7 +112,19 @@ func (u userService) Get(ctx context.Context, params map[string]string) (*entity
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Get")
 	defer span.End()
 
-	return u.repo.Get(ctx, params)
+	if err := u.authorizeUser(ctx, params["id"], entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	user, err := u.repo.Get(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if user.EmergencyContacts, err = u.repo.ListEmergencyContacts(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	return user, nil
 }
 
 func (u userService) List(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error) {
@@ -64,17 +134,54 @@ func (u userService) List(ctx context.Context, limit, offset uint64, filter map[
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"List")
 	defer span.End()
 
+	if err := u.authz.Authorize(ctx, entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
 	return u.repo.List(ctx, limit, offset, filter)
 }
 
-func (u userService) Update(ctx context.Context, articleCategory *entity.User) error {
+// Update writes only fields of the user, all set fields when it is empty
+func (u userService) Update(ctx context.Context, articleCategory *entity.User, fields []string) error {
 	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
 	defer cancel()
 
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Update")
 	defer span.End()
 
-	return u.repo.Update(ctx, articleCategory)
+	if err := u.authorizeUser(ctx, articleCategory.Id, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+
+	// the version the client read is required, otherwise concurrent edits overwrite each other
+	if articleCategory.Version == 0 {
+		return entity.NewErrNoRequiredParameter("version")
+	}
+
+	if articleCategory.Password != "" {
+		return passwordUpdateError()
+	}
+	fields, err := updateMask(fields, userUpdateFields, articleCategory)
+	if err != nil {
+		return err
+	}
+	if err := validateProfile(articleCategory); err != nil {
+		return err
+	}
+	articleCategory.UpdatedAt = time.Now()
+
+	before, err := u.repo.Get(ctx, map[string]string{"id": articleCategory.Id})
+	if err != nil {
+		return err
+	}
+	if err := u.repo.Update(ctx, articleCategory, fields); err != nil {
+		return err
+	}
+
+	u.audit.record(ctx, entity.AuditEntityUser, articleCategory.Id, entity.AuditActionUpdate,
+		auditChanges(fields, userUpdateFields, before, articleCategory))
+
+	return nil
 }
 
 func (u userService) Delete(ctx context.Context, guid string) error {
@@ -84,7 +191,16 @@ func (u userService) Delete(ctx context.Context, guid string) error {
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Delete")
 	defer span.End()
 
-	return u.repo.Delete(ctx, guid)
+	if err := u.authorizeUser(ctx, guid, entity.PermissionUsersDelete); err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, guid); err != nil {
+		return err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, guid, entity.AuditActionDelete, nil)
+
+	return nil
 }
 
 func (u userService) CheckField(ctx context.Context, req *entity.CheckFieldReq) (*entity.CheckFieldResp, error) {
@@ -114,7 +230,17 @@ func (u userService) ChangePassword(ctx context.Context, req *entity.ChangeUserP
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ChangePassword")
 	defer span.End()
 
-	return u.repo.ChangePassword(ctx, req)
+	resp, err := u.repo.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status {
+		metrics.PasswordChanges.WithLabelValues("user").Inc()
+		u.audit.record(ctx, entity.AuditEntityUser, resp.Id, entity.AuditActionChangePassword,
+			map[string]entity.AuditChange{"password": secretChange()})
+	}
+
+	return resp, nil
 }
 
 func (u userService) UpdateRefreshToken(ctx context.Context, req *entity.UpdateRefreshTokenReq) (*entity.UpdateRefreshTokenResp, error) {
@@ -124,5 +250,183 @@ func (u userService) UpdateRefreshToken(ctx context.Context, req *entity.UpdateR
 	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"UpdateRefreshToken")
 	defer span.End()
 
-	return u.repo.UpdateRefreshToken(ctx, req)
+	resp, err := u.repo.UpdateRefreshToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status {
+		u.audit.record(ctx, entity.AuditEntityUser, req.Id, entity.AuditActionUpdateRefreshToken,
+			map[string]entity.AuditChange{"refresh_token": secretChange()})
+	}
+
+	return resp, nil
+}
+
+func (u userService) ListDeleted(ctx context.Context, limit, offset uint64, filter map[string]string) ([]*entity.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ListDeleted")
+	defer span.End()
+
+	if err := u.authz.Authorize(ctx, entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListDeleted(ctx, limit, offset, filter)
+}
+
+func (u userService) Restore(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Restore")
+	defer span.End()
+
+	if err := u.authz.Authorize(ctx, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+
+	if err := u.repo.Restore(ctx, id); err != nil {
+		return err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, id, entity.AuditActionRestore, nil)
+
+	return nil
+}
+
+// Purge hard-deletes users soft-deleted longer than retention ago
+func (u userService) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Purge")
+	defer span.End()
+
+	purged, err := u.repo.Purge(ctx, retention)
+	if err != nil {
+		return 0, err
+	}
+	metrics.AccountsPurged.WithLabelValues("user").Add(float64(purged))
+
+	return purged, nil
+}
+
+// ExportData collects everything held about a user for a data subject access request
+func (u userService) ExportData(ctx context.Context, id string) (*entity.UserDataExport, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ExportData")
+	defer span.End()
+
+	if err := u.authorizeUser(ctx, id, entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	user, err := u.repo.GetPersonalData(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &entity.UserDataExport{
+		GeneratedAt: time.Now().UTC(),
+		Profile: entity.UserProfileExport{
+			Id:                user.Id,
+			UserOrder:         user.UserOrder,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			BirthDate:         user.BirthDate,
+			PhoneNumber:       user.PhoneNumber,
+			Gender:            user.Gender,
+			Status:            user.Status,
+			StatusReason:      user.StatusReason,
+			Email:             user.Email,
+			PreferredLanguage: user.PreferredLanguage,
+			AddressLine:       user.AddressLine,
+			City:              user.City,
+			Country:           user.Country,
+			AvatarKey:         user.AvatarKey,
+			CreatedAt:         user.CreatedAt,
+		},
+		Sessions: []entity.SessionExport{
+			{Kind: "refresh_token", Active: user.RefreshToken != ""},
+		},
+	}
+	if !user.UpdatedAt.IsZero() {
+		export.Profile.UpdatedAt = &user.UpdatedAt
+	}
+	if !user.DeletedAt.IsZero() {
+		export.Profile.DeletedAt = &user.DeletedAt
+	}
+	if !user.EmailVerifiedAt.IsZero() {
+		export.Profile.EmailVerifiedAt = &user.EmailVerifiedAt
+	}
+
+	contacts, err := u.repo.ListEmergencyContacts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, contact := range contacts {
+		export.EmergencyContacts = append(export.EmergencyContacts, entity.EmergencyContactExport{
+			FullName:     contact.FullName,
+			Relationship: contact.Relationship,
+			PhoneNumber:  contact.PhoneNumber,
+			CreatedAt:    contact.CreatedAt,
+		})
+	}
+
+	consents, err := u.repo.ListConsents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, consent := range consents {
+		consentExport := entity.ConsentExport{
+			DocumentType:    consent.DocumentType,
+			DocumentVersion: consent.DocumentVersion,
+			IP:              consent.IP,
+			AcceptedAt:      consent.AcceptedAt,
+		}
+		if !consent.WithdrawnAt.IsZero() {
+			consentExport.WithdrawnAt = &consent.WithdrawnAt
+		}
+		export.Consents = append(export.Consents, consentExport)
+	}
+
+	if u.audit.repo != nil {
+		history, err := u.audit.repo.List(ctx, 0, 0, map[string]string{
+			"entity_type": entity.AuditEntityUser,
+			"entity_id":   id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range history {
+			export.AuditLog = append(export.AuditLog, *entry)
+		}
+	}
+
+	return export, nil
+}
+
+// Erase anonymises the personal data of a user, the caller announces it with a user.erased event.
+// Erasing an erased user returns the same event, a caller that failed to send it retries
+func (u userService) Erase(ctx context.Context, id string) (*entity.UserErased, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"Erase")
+	defer span.End()
+
+	if err := u.authorizeUser(ctx, id, entity.PermissionUsersDelete); err != nil {
+		return nil, err
+	}
+
+	erasedAt, err := u.repo.Erase(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, id, entity.AuditActionErase, nil)
+
+	return &entity.UserErased{UserId: id, ErasedAt: erasedAt}, nil
 }
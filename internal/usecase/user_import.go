@@ -0,0 +1,339 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/metrics"
+	"dennic_user_service/internal/pkg/otlp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxImportRows bounds a single import, larger files are split by the clinic
+const maxImportRows = 10000
+
+// importColumns are the columns an import accepts, in the header of the CSV
+var importColumns = map[string]func(*entity.User) *string{
+	"first_name":         func(u *entity.User) *string { return &u.FirstName },
+	"last_name":          func(u *entity.User) *string { return &u.LastName },
+	"birth_date":         func(u *entity.User) *string { return &u.BirthDate },
+	"phone_number":       func(u *entity.User) *string { return &u.PhoneNumber },
+	"gender":             func(u *entity.User) *string { return &u.Gender },
+	"email":              func(u *entity.User) *string { return &u.Email },
+	"preferred_language": func(u *entity.User) *string { return &u.PreferredLanguage },
+	"address_line":       func(u *entity.User) *string { return &u.AddressLine },
+	"city":               func(u *entity.User) *string { return &u.City },
+	"country":            func(u *entity.User) *string { return &u.Country },
+}
+
+var requiredImportColumns = []string{"first_name", "last_name", "birth_date", "phone_number", "gender"}
+
+var (
+	// phoneNumber is an international number after normalisation
+	phoneNumber = regexp.MustCompile(`^\+[0-9]{9,15}$`)
+	// phoneSeparators are dropped from phone numbers
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+	// birthDateLayouts are the date formats spreadsheets export
+	birthDateLayouts = []string{"2006-01-02", "02.01.2006", "02/01/2006"}
+	// genders are the values of the gender_type enum
+	genders = []string{"male", "female"}
+)
+
+// importRow is a parsed row waiting for the checks that need the whole file
+type importRow struct {
+	result *entity.UserImportRow
+	user   *entity.User
+}
+
+// ImportUsers creates the users of a CSV with a header row, rows are validated and normalised one
+// by one, a phone number that is taken or repeated marks the row a duplicate, the valid rows are
+// written together and a dry run writes nothing, imported users have no password and have not
+// accepted the terms yet
+func (u userService) ImportUsers(ctx context.Context, r io.Reader, dryRun bool) (*entity.UserImportReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ImportUsers")
+	defer span.End()
+
+	if err := u.authz.Authorize(ctx, entity.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+
+	rows, err := parseImport(r)
+	if err != nil {
+		return nil, err
+	}
+	report := &entity.UserImportReport{DryRun: dryRun}
+	for _, row := range rows {
+		report.Rows = append(report.Rows, row.result)
+	}
+
+	if err := u.markDuplicates(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	var users []*entity.User
+	now := time.Now()
+	for _, row := range rows {
+		if row.result.Status == entity.ImportStatusValid && !dryRun {
+			row.user.Id = uuid.New().String()
+			row.user.Status = entity.UserStatusActive
+			row.user.CreatedAt = now
+			row.result.UserId = row.user.Id
+			users = append(users, row.user)
+		}
+	}
+	if len(users) != 0 {
+		if _, err := u.repo.CopyUsers(ctx, users); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if row.result.Status == entity.ImportStatusValid {
+				row.result.Status = entity.ImportStatusImported
+				u.audit.record(ctx, entity.AuditEntityUser, row.user.Id, entity.AuditActionImport,
+					auditChanges(fieldNames(userAuditFields), userAuditFields, &entity.User{}, row.user))
+			}
+		}
+	}
+
+	for _, row := range report.Rows {
+		switch row.Status {
+		case entity.ImportStatusImported:
+			report.Imported++
+		case entity.ImportStatusDuplicate:
+			report.Duplicates++
+		case entity.ImportStatusFailed:
+			report.Failed++
+		}
+		if !dryRun {
+			metrics.UsersImported.WithLabelValues(row.Status).Inc()
+		}
+	}
+
+	return report, nil
+}
+
+// parseImport reads the header and every row, a row that can not be parsed fails on its own
+func parseImport(r io.Reader) ([]*importRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, entity.NewErrNoRequiredParameter(requiredImportColumns...)
+		}
+		return nil, err
+	}
+	columns := make([]string, len(header))
+	present := make(map[string]bool)
+	var unknown []string
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if _, ok := importColumns[column]; !ok {
+			unknown = append(unknown, column)
+		}
+		columns[i] = column
+		present[column] = true
+	}
+	var missing []string
+	for _, column := range requiredImportColumns {
+		if !present[column] {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) != 0 || len(unknown) != 0 {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = errors.New("invalid import header")
+		if len(missing) != 0 {
+			errValidation.Errors["header"] = "missing columns " + strings.Join(missing, ", ")
+		}
+		if len(unknown) != 0 {
+			errValidation.Errors["columns"] = "unknown columns " + strings.Join(unknown, ", ")
+		}
+		return nil, errValidation
+	}
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var errParse *csv.ParseError
+		if errors.As(err, &errParse) && !errors.Is(err, csv.ErrFieldCount) {
+			rows = append(rows, &importRow{result: &entity.UserImportRow{
+				Line:   errParse.StartLine,
+				Status: entity.ImportStatusFailed,
+				Errors: map[string]string{"row": errParse.Err.Error()},
+			}})
+			continue
+		}
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return nil, err
+		}
+		if len(rows) == maxImportRows {
+			errValidation := entity.NewErrValidation()
+			errValidation.Err = fmt.Errorf("import has more than %d rows", maxImportRows)
+			errValidation.Errors["rows"] = fmt.Sprintf("an import may have at most %d rows", maxImportRows)
+			return nil, errValidation
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &importRow{
+			result: &entity.UserImportRow{Line: line},
+			user:   &entity.User{},
+		}
+		if errors.Is(err, csv.ErrFieldCount) {
+			row.result.Status = entity.ImportStatusFailed
+			row.result.Errors = map[string]string{"row": fmt.Sprintf("row has %d columns, the header %d", len(record), len(columns))}
+		} else {
+			for i, value := range record {
+				*importColumns[columns[i]](row.user) = strings.TrimSpace(value)
+			}
+			normaliseImportedUser(row.user)
+			row.result.PhoneNumber = row.user.PhoneNumber
+			row.result.Status = entity.ImportStatusValid
+			if errs := validateImportedUser(row.user); len(errs) != 0 {
+				row.result.Status = entity.ImportStatusFailed
+				row.result.Errors = errs
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// normaliseImportedUser brings the values of a row to the form Create stores
+func normaliseImportedUser(user *entity.User) {
+	phone := phoneSeparators.Replace(user.PhoneNumber)
+	if strings.HasPrefix(phone, "00") {
+		phone = "+" + phone[2:]
+	} else if phone != "" && !strings.HasPrefix(phone, "+") {
+		phone = "+" + phone
+	}
+	user.PhoneNumber = phone
+	user.Gender = strings.ToLower(user.Gender)
+	user.Country = strings.ToUpper(user.Country)
+	for _, layout := range birthDateLayouts {
+		if birthDate, err := time.Parse(layout, user.BirthDate); err == nil {
+			user.BirthDate = birthDate.Format("2006-01-02")
+			break
+		}
+	}
+}
+
+// validateImportedUser returns what is wrong with each column of a normalised row
+func validateImportedUser(user *entity.User) map[string]string {
+	errs := make(map[string]string)
+	for _, column := range requiredImportColumns {
+		if *importColumns[column](user) == "" {
+			errs[column] = column + " is required"
+		}
+	}
+	for column, value := range map[string]string{"first_name": user.FirstName, "last_name": user.LastName} {
+		if utf8.RuneCountInString(value) > 50 {
+			errs[column] = column + " may have at most 50 characters"
+		}
+	}
+	if user.PhoneNumber != "" && !phoneNumber.MatchString(user.PhoneNumber) {
+		errs["phone_number"] = "phone_number must be an international number like +998901234567"
+	}
+	if user.BirthDate != "" {
+		birthDate, err := time.Parse("2006-01-02", user.BirthDate)
+		if err != nil {
+			errs["birth_date"] = "birth_date must be a date like 2000-08-30 or 30.08.2000"
+		} else if birthDate.After(time.Now()) || birthDate.Year() < 1900 {
+			errs["birth_date"] = "birth_date must be between 1900 and today"
+		}
+	}
+	if user.Gender != "" && user.Gender != genders[0] && user.Gender != genders[1] {
+		errs["gender"] = "gender must be " + strings.Join(genders, " or ")
+	}
+	var errValidation *entity.ErrValidation
+	if err := validateProfile(user); errors.As(err, &errValidation) {
+		for column, message := range errValidation.Errors {
+			errs[column] = message
+		}
+	}
+	return errs
+}
+
+// markDuplicates checks the valid rows against each other and against the live users, a repeated or
+// taken phone number is a duplicate, a repeated or taken email fails the row
+func (u userService) markDuplicates(ctx context.Context, rows []*importRow) error {
+	var (
+		phones     []string
+		emails     []string
+		phoneLines = make(map[string]int)
+		emailLines = make(map[string]int)
+	)
+	for _, row := range rows {
+		if row.result.Status != entity.ImportStatusValid {
+			continue
+		}
+		user := row.user
+		email := strings.ToLower(user.Email)
+		if line, ok := phoneLines[user.PhoneNumber]; ok {
+			row.result.Status = entity.ImportStatusDuplicate
+			row.result.Errors = map[string]string{"phone_number": fmt.Sprintf("phone_number repeats line %d", line)}
+			continue
+		}
+		if line, ok := emailLines[email]; ok && email != "" {
+			row.result.Status = entity.ImportStatusFailed
+			row.result.Errors = map[string]string{"email": fmt.Sprintf("email repeats line %d", line)}
+			continue
+		}
+		phoneLines[user.PhoneNumber] = row.result.Line
+		phones = append(phones, user.PhoneNumber)
+		if email != "" {
+			emailLines[email] = row.result.Line
+			emails = append(emails, email)
+		}
+	}
+	if len(phones) == 0 {
+		return nil
+	}
+
+	existing, err := u.repo.FindByContacts(ctx, phones, emails)
+	if err != nil {
+		return err
+	}
+	takenPhones := make(map[string]string)
+	takenEmails := make(map[string]bool)
+	for _, user := range existing {
+		takenPhones[user.PhoneNumber] = user.Id
+		if user.Email != "" {
+			takenEmails[strings.ToLower(user.Email)] = true
+		}
+	}
+
+	for _, row := range rows {
+		if row.result.Status != entity.ImportStatusValid {
+			continue
+		}
+		if id, ok := takenPhones[row.user.PhoneNumber]; ok {
+			row.result.Status = entity.ImportStatusDuplicate
+			row.result.UserId = id
+			row.result.Errors = map[string]string{"phone_number": "phone_number belongs to a user"}
+			continue
+		}
+		if takenEmails[strings.ToLower(row.user.Email)] {
+			row.result.Status = entity.ImportStatusFailed
+			row.result.Errors = map[string]string{"email": "email belongs to a user"}
+		}
+	}
+
+	return nil
+}
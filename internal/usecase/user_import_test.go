@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UserImportTestSuite struct {
+	suite.Suite
+	repo  *importStorageStub
+	audit *auditStorageStub
+	user  userService
+}
+
+// importStorageStub holds the live users an import is checked against and records what it copies
+type importStorageStub struct {
+	*userStorageStub
+	existing []*entity.User
+	copied   []*entity.User
+}
+
+func (i *importStorageStub) FindByContacts(context.Context, []string, []string) ([]*entity.User, error) {
+	return i.existing, nil
+}
+
+func (i *importStorageStub) CopyUsers(_ context.Context, users []*entity.User) (int64, error) {
+	i.copied = append(i.copied, users...)
+	return int64(len(users)), nil
+}
+
+func (s *UserImportTestSuite) SetupTest() {
+	s.repo = &importStorageStub{
+		userStorageStub: &userStorageStub{},
+		existing:        []*entity.User{{Id: "existing", PhoneNumber: "+998901111111", Email: "taken@dennic.uz"}},
+	}
+	s.audit = &auditStorageStub{}
+	s.user = NewUserService(time.Second, s.repo, s.audit, NewRoleService(time.Second, nil, 0))
+}
+
+const importCSV = `First_Name,last_name,birth_date,phone_number,gender,email
+Ali,Valiyev,30.08.2000,+998 (90) 123-45-67,Male,
+Vali,Aliyev,2001-01-02,998901234567,male,
+Olim,Karimov,2002-03-04,00998901111111,male,
+Aziza,Karimova,2030-01-01,,female,not an email
+Zarina,Karimova,2003-04-05,+998907777777,female,TAKEN@dennic.uz
+Bobur,Karimov,"unterminated
+`
+
+func (s *UserImportTestSuite) TestImport() {
+	report, err := s.user.ImportUsers(internalCall(), strings.NewReader(importCSV), false)
+	s.Require().NoError(err)
+	s.Require().Len(report.Rows, 6)
+
+	imported := report.Rows[0]
+	s.Equal(2, imported.Line)
+	s.Equal(entity.ImportStatusImported, imported.Status)
+	s.Equal("+998901234567", imported.PhoneNumber)
+	s.NotEmpty(imported.UserId)
+	s.Require().Len(s.repo.copied, 1)
+	s.Equal("2000-08-30", s.repo.copied[0].BirthDate)
+	s.Equal("male", s.repo.copied[0].Gender)
+	s.Equal(entity.AuditActionImport, s.audit.entries[0].Action)
+
+	// the same number written differently repeats the first row
+	s.Equal(entity.ImportStatusDuplicate, report.Rows[1].Status)
+	s.Contains(report.Rows[1].Errors["phone_number"], "line 2")
+
+	s.Equal(entity.ImportStatusDuplicate, report.Rows[2].Status)
+	s.Equal("existing", report.Rows[2].UserId)
+
+	s.Equal(entity.ImportStatusFailed, report.Rows[3].Status)
+	s.Contains(report.Rows[3].Errors, "birth_date")
+	s.Contains(report.Rows[3].Errors, "phone_number")
+	s.Contains(report.Rows[3].Errors, "email")
+
+	s.Equal(entity.ImportStatusFailed, report.Rows[4].Status)
+	s.Contains(report.Rows[4].Errors, "email")
+
+	s.Equal(entity.ImportStatusFailed, report.Rows[5].Status)
+	s.Equal(7, report.Rows[5].Line)
+
+	s.Equal(1, report.Imported)
+	s.Equal(2, report.Duplicates)
+	s.Equal(3, report.Failed)
+}
+
+func (s *UserImportTestSuite) TestDryRun() {
+	report, err := s.user.ImportUsers(internalCall(), strings.NewReader(importCSV), true)
+	s.Require().NoError(err)
+	s.True(report.DryRun)
+	s.Equal(entity.ImportStatusValid, report.Rows[0].Status)
+	s.Empty(report.Rows[0].UserId)
+	s.Zero(report.Imported)
+	s.Empty(s.repo.copied)
+	s.Empty(s.audit.entries)
+}
+
+func (s *UserImportTestSuite) TestHeader() {
+	var errValidation *entity.ErrValidation
+	_, err := s.user.ImportUsers(internalCall(), strings.NewReader("first_name,last_name,phone,gender\n"), true)
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors["header"], "birth_date")
+	s.Contains(errValidation.Errors["columns"], "phone")
+
+	var errDenied *entity.ErrPermissionDenied
+	_, err = s.user.ImportUsers(actingUser("patient"), strings.NewReader(importCSV), true)
+	s.ErrorAs(err, &errDenied)
+}
+
+func TestUserImportTestSuite(t *testing.T) {
+	suite.Run(t, new(UserImportTestSuite))
+}
@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MergeServiceName = "mergeService"
+	MergeSpanName    = "mergeUsecase"
+)
+
+type MergeStorageI interface {
+	FindDuplicates(ctx context.Context) (int64, error)
+	ListDuplicates(ctx context.Context, limit, offset uint64) ([]*entity.DuplicateCandidate, error)
+	Merge(ctx context.Context, survivorId, mergedId string) (*entity.UserMerged, error)
+}
+
+type mergeService struct {
+	repo        repository.UserStorageI
+	medical     repository.MedicalStorageI
+	audit       auditLog
+	authz       Authorizer
+	ctxTimeout  time.Duration
+	scanTimeout time.Duration
+	threshold   float64
+}
+
+// NewMergeService finds users registered twice and merges them, a search runs for at most
+// scanTimeout and keeps the pairs scoring threshold or more. medical may be nil when medical
+// profiles can not be read, a merge then leaves them with the merged account
+func NewMergeService(ctxTimeout time.Duration, repo repository.UserStorageI, medical repository.MedicalStorageI,
+	audit repository.AuditStorageI, authz Authorizer, scanTimeout time.Duration, threshold float64) mergeService {
+	return mergeService{
+		ctxTimeout:  ctxTimeout,
+		repo:        repo,
+		medical:     medical,
+		audit:       auditLog{repo: audit},
+		authz:       authz,
+		scanTimeout: scanTimeout,
+		threshold:   threshold,
+	}
+}
+
+// FindDuplicates scores every pair of live users sharing a birth date and replaces the duplicates
+// found before with the pairs scoring at least the threshold, it returns how many were found
+func (m mergeService) FindDuplicates(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.scanTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, MergeServiceName, MergeSpanName+"FindDuplicates")
+	defer span.End()
+
+	if err := m.authz.Authorize(ctx, entity.PermissionUsersMerge); err != nil {
+		return 0, err
+	}
+
+	foundAt := time.Now().UTC()
+	var candidates []*entity.DuplicateCandidate
+	err := m.repo.DuplicatePairs(ctx, func(user, other *entity.User) error {
+		if score := duplicateScore(user, other); score >= m.threshold {
+			candidates = append(candidates, &entity.DuplicateCandidate{User: user, Duplicate: other, Score: score, FoundAt: foundAt})
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := m.repo.ReplaceDuplicates(ctx, candidates); err != nil {
+		return 0, err
+	}
+
+	return int64(len(candidates)), nil
+}
+
+// ListDuplicates returns the duplicates found by the last search that are both still live,
+// the most similar pair first
+func (m mergeService) ListDuplicates(ctx context.Context, limit, offset uint64) ([]*entity.DuplicateCandidate, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, MergeServiceName, MergeSpanName+"ListDuplicates")
+	defer span.End()
+
+	if err := m.authz.Authorize(ctx, entity.PermissionUsersMerge); err != nil {
+		return nil, err
+	}
+
+	return m.repo.ListDuplicates(ctx, limit, offset)
+}
+
+// Merge folds mergedId into survivorId: dependants, guardian, emergency contacts, consents, the
+// session and the medical profile move to the survivor when it has none of its own, and the merged
+// account is soft-deleted. Other services learn of the merge from the returned event, merging
+// the same users again returns it without changing anything
+func (m mergeService) Merge(ctx context.Context, survivorId, mergedId string) (*entity.UserMerged, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, MergeServiceName, MergeSpanName+"Merge")
+	defer span.End()
+
+	if err := m.authz.Authorize(ctx, entity.PermissionUsersMerge); err != nil {
+		return nil, err
+	}
+
+	if survivorId == "" || mergedId == "" {
+		return nil, entity.NewErrNoRequiredParameter("survivor_id", "merged_id")
+	}
+	if survivorId == mergedId {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = fmt.Errorf("user %s can not be merged into itself", mergedId)
+		errValidation.Errors["merged_id"] = "merged_id must differ from survivor_id"
+		return nil, errValidation
+	}
+	// a repeated merge returns the first one, a caller that failed to send its event retries
+	var errNotFound *entity.ErrNotFound
+	mergedAt, err := m.repo.GetMerge(ctx, survivorId, mergedId)
+	if err == nil {
+		return &entity.UserMerged{SurvivorId: survivorId, MergedId: mergedId, MergedAt: mergedAt}, nil
+	}
+	if !errors.As(err, &errNotFound) {
+		return nil, err
+	}
+	for _, id := range []string{survivorId, mergedId} {
+		if _, err := m.repo.Get(ctx, map[string]string{"id": id}); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.validateGuardianship(ctx, survivorId, mergedId); err != nil {
+		return nil, err
+	}
+	// the profile is copied before the merge, a failed merge leaves a copy rather than losing it
+	if err := m.copyMedicalProfile(ctx, survivorId, mergedId); err != nil {
+		return nil, err
+	}
+
+	mergedAt, err = m.repo.Merge(ctx, survivorId, mergedId)
+	if err != nil {
+		return nil, err
+	}
+
+	m.audit.record(ctx, entity.AuditEntityUser, survivorId, entity.AuditActionMerge, map[string]entity.AuditChange{
+		"merged_user_id": {After: mergedId},
+	})
+	m.audit.record(ctx, entity.AuditEntityUser, mergedId, entity.AuditActionMerge, map[string]entity.AuditChange{
+		"merged_into": {After: survivorId},
+	})
+
+	return &entity.UserMerged{SurvivorId: survivorId, MergedId: mergedId, MergedAt: mergedAt}, nil
+}
+
+// validateGuardianship rejects merges that would make the survivor its own guardian or both a
+// dependant and a guardian, a user is one or the other
+func (m mergeService) validateGuardianship(ctx context.Context, survivorId, mergedId string) error {
+	var (
+		guardians  = make(map[string]string, 2)
+		dependants int
+	)
+	for _, id := range []string{survivorId, mergedId} {
+		dependant, err := m.repo.GetDependant(ctx, id)
+		var errNotFound *entity.ErrNotFound
+		switch {
+		case err == nil:
+			guardians[id] = dependant.GuardianId
+		case !errors.As(err, &errNotFound):
+			return err
+		}
+
+		list, err := m.repo.ListDependants(ctx, id)
+		if err != nil {
+			return err
+		}
+		dependants += len(list)
+	}
+
+	var reason string
+	switch {
+	case guardians[survivorId] == mergedId || guardians[mergedId] == survivorId:
+		reason = "one user is a dependant of the other"
+	case guardians[survivorId] != "" && guardians[mergedId] != "" && guardians[survivorId] != guardians[mergedId]:
+		reason = "the users are dependants of different guardians"
+	case len(guardians) != 0 && dependants != 0:
+		reason = "the merged user would be both a dependant and a guardian"
+	default:
+		return nil
+	}
+	errValidation := entity.NewErrValidation()
+	errValidation.Err = fmt.Errorf("user %s can not be merged into %s: %s", mergedId, survivorId, reason)
+	errValidation.Errors["merged_id"] = reason
+	return errValidation
+}
+
+// copyMedicalProfile gives the survivor the medical profile of the merged user unless it has one,
+// profiles are encrypted bound to their user so they are copied rather than moved
+func (m mergeService) copyMedicalProfile(ctx context.Context, survivorId, mergedId string) error {
+	if m.medical == nil {
+		return nil
+	}
+
+	var errNotFound *entity.ErrNotFound
+	profile, err := m.medical.Get(ctx, mergedId)
+	if errors.As(err, &errNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := m.medical.Get(ctx, survivorId); !errors.As(err, &errNotFound) {
+		return err
+	}
+
+	profile.UserId = survivorId
+	profile.Version = 0
+	return m.medical.Save(ctx, profile)
+}
+
+// duplicateScore is the similarity of the names of two users born on the same day, 0 otherwise.
+// First and last names are compared on their own and the other way round, as they are often swapped
+func duplicateScore(user, other *entity.User) float64 {
+	if user.BirthDate != other.BirthDate {
+		return 0
+	}
+	straight := (nameSimilarity(user.FirstName, other.FirstName) + nameSimilarity(user.LastName, other.LastName)) / 2
+	swapped := (nameSimilarity(user.FirstName, other.LastName) + nameSimilarity(user.LastName, other.FirstName)) / 2
+	if swapped > straight {
+		return swapped
+	}
+	return straight
+}
+
+// nameSimilarity is the Jaro-Winkler similarity of two names regardless of case, 1 for the same name
+// and 0 when no letter matches, names sharing their first letters score higher
+func nameSimilarity(a, b string) float64 {
+	runesA := []rune(strings.ToLower(strings.TrimSpace(a)))
+	runesB := []rune(strings.ToLower(strings.TrimSpace(b)))
+	if len(runesA) == 0 || len(runesB) == 0 {
+		return 0
+	}
+
+	// letters match when they are equal and no further apart than half the longer name
+	window := len(runesA)
+	if len(runesB) > window {
+		window = len(runesB)
+	}
+	window = window/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matchedA, matchedB := make([]bool, len(runesA)), make([]bool, len(runesB))
+	var matches float64
+	for i, r := range runesA {
+		for j := i - window; j <= i+window && j < len(runesB); j++ {
+			if j >= 0 && !matchedB[j] && runesB[j] == r {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// half the matched letters that are out of order count as transpositions
+	var transpositions float64
+	for i, j := 0, 0; i < len(runesA); i++ {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if runesA[i] != runesB[j] {
+			transpositions++
+		}
+		j++
+	}
+	jaro := (matches/float64(len(runesA)) + matches/float64(len(runesB)) + (matches-transpositions/2)/matches) / 3
+
+	var prefix float64
+	for i := 0; i < len(runesA) && i < len(runesB) && i < 4 && runesA[i] == runesB[i]; i++ {
+		prefix++
+	}
+	return jaro + prefix*0.1*(1-jaro)
+}
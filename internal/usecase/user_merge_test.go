@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MergeTestSuite struct {
+	suite.Suite
+	repo    *mergeStorageStub
+	medical *medicalStorageStub
+	audit   *auditStorageStub
+	merge   mergeService
+}
+
+// mergeStorageStub keeps live users and guardianships by id and the pairs a search hands it
+type mergeStorageStub struct {
+	repository.UserStorageI
+	users      map[string]*entity.User
+	guardians  map[string]string
+	pairs      [][2]*entity.User
+	duplicates []*entity.DuplicateCandidate
+	merged     [2]string
+}
+
+func (m *mergeStorageStub) Get(_ context.Context, params map[string]string) (*entity.User, error) {
+	user, ok := m.users[params["id"]]
+	if !ok {
+		return nil, entity.NewErrNotFound("user")
+	}
+	return user, nil
+}
+
+func (m *mergeStorageStub) GetDependant(_ context.Context, id string) (*entity.Dependant, error) {
+	guardianId, ok := m.guardians[id]
+	if !ok {
+		return nil, entity.NewErrNotFound("dependant")
+	}
+	return &entity.Dependant{User: m.users[id], GuardianId: guardianId}, nil
+}
+
+func (m *mergeStorageStub) ListDependants(_ context.Context, guardianId string) ([]*entity.Dependant, error) {
+	var dependants []*entity.Dependant
+	for id, guardian := range m.guardians {
+		if guardian == guardianId {
+			dependants = append(dependants, &entity.Dependant{User: m.users[id], GuardianId: guardian})
+		}
+	}
+	return dependants, nil
+}
+
+func (m *mergeStorageStub) DuplicatePairs(_ context.Context, fn func(user, other *entity.User) error) error {
+	for _, pair := range m.pairs {
+		if err := fn(pair[0], pair[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mergeStorageStub) ReplaceDuplicates(_ context.Context, candidates []*entity.DuplicateCandidate) error {
+	m.duplicates = candidates
+	return nil
+}
+
+func (m *mergeStorageStub) Merge(_ context.Context, survivorId, mergedId string) (time.Time, error) {
+	m.merged = [2]string{survivorId, mergedId}
+	delete(m.users, mergedId)
+	return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), nil
+}
+
+func (m *mergeStorageStub) GetMerge(_ context.Context, survivorId, mergedId string) (time.Time, error) {
+	if m.merged != [2]string{survivorId, mergedId} {
+		return time.Time{}, entity.NewErrNotFound("merge")
+	}
+	return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), nil
+}
+
+func (s *MergeTestSuite) SetupTest() {
+	s.repo = &mergeStorageStub{
+		users: map[string]*entity.User{
+			"survivor": {Id: "survivor", FirstName: "Ali", LastName: "Valiyev", BirthDate: "1990-05-01"},
+			"merged":   {Id: "merged", FirstName: "Aliy", LastName: "Valiev", BirthDate: "1990-05-01"},
+			"guardian": {Id: "guardian", FirstName: "Vali", LastName: "Valiyev", BirthDate: "1960-01-01"},
+		},
+		guardians: make(map[string]string),
+	}
+	s.medical = &medicalStorageStub{profiles: make(map[string]*entity.MedicalProfile)}
+	s.audit = &auditStorageStub{}
+	s.merge = NewMergeService(time.Second, s.repo, s.medical, s.audit, NewRoleService(time.Second, nil, 0), time.Second, 0.9)
+}
+
+func (s *MergeTestSuite) TestNameSimilarity() {
+	s.Equal(1.0, nameSimilarity("Valiyev", " VALIYEV"))
+	s.Equal(0.0, nameSimilarity("Ali", "M"))
+	s.Equal(0.0, nameSimilarity("", "Ali"))
+	s.InDelta(0.9417, nameSimilarity("Ali", "Aliy"), 0.0001)
+	s.InDelta(0.9619, nameSimilarity("Valiyev", "Vailyev"), 0.0001)
+
+	user := &entity.User{FirstName: "Ali", LastName: "Valiyev", BirthDate: "1990-05-01"}
+	s.Greater(duplicateScore(user, &entity.User{FirstName: "Aliy", LastName: "Valiev", BirthDate: "1990-05-01"}), 0.9)
+	s.Greater(duplicateScore(user, &entity.User{FirstName: "Valiyev", LastName: "Ali", BirthDate: "1990-05-01"}), 0.99)
+	s.Less(duplicateScore(user, &entity.User{FirstName: "Olim", LastName: "Karimov", BirthDate: "1990-05-01"}), 0.7)
+	s.Equal(0.0, duplicateScore(user, &entity.User{FirstName: "Ali", LastName: "Valiyev", BirthDate: "1990-01-05"}))
+}
+
+func (s *MergeTestSuite) TestFindDuplicates() {
+	users := s.repo.users
+	s.repo.pairs = [][2]*entity.User{
+		{users["merged"], users["survivor"]},
+		{users["guardian"], {Id: "other", FirstName: "Vali", LastName: "Karimov", BirthDate: "1960-01-01"}},
+	}
+
+	found, err := s.merge.FindDuplicates(internalCall())
+	s.Require().NoError(err)
+	s.Equal(int64(1), found)
+	s.Require().Len(s.repo.duplicates, 1)
+	s.Equal("merged", s.repo.duplicates[0].User.Id)
+	s.Equal("survivor", s.repo.duplicates[0].Duplicate.Id)
+	s.False(s.repo.duplicates[0].FoundAt.IsZero())
+
+	var errDenied *entity.ErrPermissionDenied
+	_, err = s.merge.FindDuplicates(actingUser("survivor"))
+	s.ErrorAs(err, &errDenied)
+}
+
+func (s *MergeTestSuite) TestMerge() {
+	s.medical.profiles["merged"] = &entity.MedicalProfile{UserId: "merged", BloodType: "A+", Version: 3}
+	s.repo.guardians["merged"] = "guardian"
+
+	merged, err := s.merge.Merge(internalCall(), "survivor", "merged")
+	s.Require().NoError(err)
+	s.Equal(&entity.UserMerged{SurvivorId: "survivor", MergedId: "merged", MergedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, merged)
+	s.Equal([2]string{"survivor", "merged"}, s.repo.merged)
+
+	// the profile is sealed for its user, the survivor gets a copy of its own
+	s.Require().Contains(s.medical.profiles, "survivor")
+	s.Equal("A+", s.medical.profiles["survivor"].BloodType)
+	s.Equal(uint64(1), s.medical.profiles["survivor"].Version)
+
+	s.Require().Len(s.audit.entries, 2)
+	s.Equal(entity.AuditActionMerge, s.audit.entries[0].Action)
+	s.Equal("survivor", s.audit.entries[0].EntityId)
+	s.Equal("merged", s.audit.entries[0].Changes["merged_user_id"].After)
+	s.Equal("survivor", s.audit.entries[1].Changes["merged_into"].After)
+
+	// merging again returns the same event for it to be sent again
+	again, err := s.merge.Merge(internalCall(), "survivor", "merged")
+	s.Require().NoError(err)
+	s.Equal(merged, again)
+	s.Len(s.audit.entries, 2)
+}
+
+func (s *MergeTestSuite) TestMergeKeepsMedicalProfileOfSurvivor() {
+	s.medical.profiles["survivor"] = &entity.MedicalProfile{UserId: "survivor", BloodType: "0-", Version: 1}
+	s.medical.profiles["merged"] = &entity.MedicalProfile{UserId: "merged", BloodType: "A+", Version: 1}
+
+	_, err := s.merge.Merge(internalCall(), "survivor", "merged")
+	s.Require().NoError(err)
+	s.Equal("0-", s.medical.profiles["survivor"].BloodType)
+}
+
+func (s *MergeTestSuite) TestMergeValidation() {
+	var errValidation *entity.ErrValidation
+	_, err := s.merge.Merge(internalCall(), "survivor", "survivor")
+	s.ErrorAs(err, &errValidation)
+
+	var errNoRequired *entity.ErrNoRequiredParameter
+	_, err = s.merge.Merge(internalCall(), "", "merged")
+	s.ErrorAs(err, &errNoRequired)
+
+	var errNotFound *entity.ErrNotFound
+	_, err = s.merge.Merge(internalCall(), "survivor", "missing")
+	s.ErrorAs(err, &errNotFound)
+
+	var errDenied *entity.ErrPermissionDenied
+	_, err = s.merge.Merge(actingUser("survivor"), "survivor", "merged")
+	s.ErrorAs(err, &errDenied)
+
+	// the survivor would become its own guardian
+	s.repo.guardians["survivor"] = "merged"
+	_, err = s.merge.Merge(internalCall(), "survivor", "merged")
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors["merged_id"], "dependant of the other")
+
+	// a dependant can not take over the dependants of the merged user
+	s.repo.guardians["survivor"] = "guardian"
+	s.repo.guardians["child"] = "merged"
+	_, err = s.merge.Merge(internalCall(), "survivor", "merged")
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors["merged_id"], "both a dependant and a guardian")
+
+	s.Empty(s.repo.merged)
+	s.Empty(s.audit.entries)
+}
+
+func TestMergeTestSuite(t *testing.T) {
+	suite.Run(t, new(MergeTestSuite))
+}
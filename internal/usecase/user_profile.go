@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// languageTag is a two or three letter language with an optional region, e.g. uz or ru-RU
+	languageTag = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+	// countryCode is an ISO 3166-1 alpha-2 code
+	countryCode = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// emergencyContactFields are the audited fields of an emergency contact
+var emergencyContactFields = map[string]func(*entity.EmergencyContact) string{
+	"full_name":    func(c *entity.EmergencyContact) string { return c.FullName },
+	"relationship": func(c *entity.EmergencyContact) string { return c.Relationship },
+	"phone_number": func(c *entity.EmergencyContact) string { return c.PhoneNumber },
+}
+
+// validateProfile checks the optional profile fields of a user that are set
+func validateProfile(user *entity.User) error {
+	errValidation := entity.NewErrValidation()
+
+	if user.Email != "" {
+		if address, err := mail.ParseAddress(user.Email); err != nil || address.Address != user.Email {
+			errValidation.Errors["email"] = "email is not a valid address"
+		}
+	}
+	if user.PreferredLanguage != "" && !languageTag.MatchString(user.PreferredLanguage) {
+		errValidation.Errors["preferred_language"] = "preferred_language must be a language tag like uz or ru-RU"
+	}
+	if user.Country != "" && !countryCode.MatchString(user.Country) {
+		errValidation.Errors["country"] = "country must be an ISO 3166-1 alpha-2 code"
+	}
+	// the avatar is uploaded to object storage by the client, only its key is kept
+	if user.AvatarKey != "" && (strings.Contains(user.AvatarKey, "://") || strings.HasPrefix(user.AvatarKey, "/")) {
+		errValidation.Errors["avatar_key"] = "avatar_key must be an object key, not a url or path"
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = errors.New("invalid user profile")
+		return errValidation
+	}
+	return nil
+}
+
+func validateEmergencyContact(contact *entity.EmergencyContact) error {
+	if contact.FullName == "" || contact.PhoneNumber == "" {
+		return entity.NewErrNoRequiredParameter("full_name", "phone_number")
+	}
+	return nil
+}
+
+// AddEmergencyContact adds a contact to a user who has less than entity.MaxEmergencyContacts
+func (u userService) AddEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"AddEmergencyContact")
+	defer span.End()
+
+	if err := u.authorizeUser(ctx, contact.UserId, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+	if err := validateEmergencyContact(contact); err != nil {
+		return err
+	}
+
+	if _, err := u.repo.Get(ctx, map[string]string{"id": contact.UserId}); err != nil {
+		return err
+	}
+	contacts, err := u.repo.ListEmergencyContacts(ctx, contact.UserId)
+	if err != nil {
+		return err
+	}
+	if len(contacts) >= entity.MaxEmergencyContacts {
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = fmt.Errorf("user %s has %d emergency contacts", contact.UserId, len(contacts))
+		errValidation.Errors["emergency_contacts"] = fmt.Sprintf("a user may keep at most %d emergency contacts", entity.MaxEmergencyContacts)
+		return errValidation
+	}
+
+	contact.Id = uuid.New().String()
+	if err := u.repo.CreateEmergencyContact(ctx, contact); err != nil {
+		return err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, contact.UserId, entity.AuditActionAddContact,
+		auditChanges(fieldNames(emergencyContactFields), emergencyContactFields, &entity.EmergencyContact{}, contact))
+
+	return nil
+}
+
+func (u userService) ListEmergencyContacts(ctx context.Context, userId string) ([]*entity.EmergencyContact, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ListEmergencyContacts")
+	defer span.End()
+
+	if err := u.authorizeUser(ctx, userId, entity.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListEmergencyContacts(ctx, userId)
+}
+
+// UpdateEmergencyContact replaces all fields of a contact of contact.UserId
+func (u userService) UpdateEmergencyContact(ctx context.Context, contact *entity.EmergencyContact) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"UpdateEmergencyContact")
+	defer span.End()
+
+	if err := u.authorizeUser(ctx, contact.UserId, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+	if err := validateEmergencyContact(contact); err != nil {
+		return err
+	}
+
+	contacts, err := u.repo.ListEmergencyContacts(ctx, contact.UserId)
+	if err != nil {
+		return err
+	}
+	before := &entity.EmergencyContact{}
+	for _, c := range contacts {
+		if c.Id == contact.Id {
+			before = c
+			break
+		}
+	}
+
+	if err := u.repo.UpdateEmergencyContact(ctx, contact); err != nil {
+		return err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, contact.UserId, entity.AuditActionUpdateContact,
+		auditChanges(fieldNames(emergencyContactFields), emergencyContactFields, before, contact))
+
+	return nil
+}
+
+func (u userService) DeleteEmergencyContact(ctx context.Context, userId, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"DeleteEmergencyContact")
+	defer span.End()
+
+	if err := u.authorizeUser(ctx, userId, entity.PermissionUsersWrite); err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteEmergencyContact(ctx, userId, id); err != nil {
+		return err
+	}
+	u.audit.record(ctx, entity.AuditEntityUser, userId, entity.AuditActionDeleteContact, nil)
+
+	return nil
+}
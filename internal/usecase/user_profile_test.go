@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UserProfileTestSuite struct {
+	suite.Suite
+	repo     *profileStorageStub
+	audit    *auditStorageStub
+	notifier *emailVerificationNotifierStub
+}
+
+// profileStorageStub keeps the contacts and the verification of the single user of userStorageStub
+type profileStorageStub struct {
+	*userStorageStub
+	contacts     []*entity.EmergencyContact
+	verification *entity.EmailVerification
+}
+
+func (p *profileStorageStub) ListEmergencyContacts(context.Context, string) ([]*entity.EmergencyContact, error) {
+	return p.contacts, nil
+}
+
+func (p *profileStorageStub) CreateEmergencyContact(_ context.Context, contact *entity.EmergencyContact) error {
+	p.contacts = append(p.contacts, contact)
+	return nil
+}
+
+func (p *profileStorageStub) CreateEmailVerification(_ context.Context, verification *entity.EmailVerification) error {
+	p.verification = verification
+	return nil
+}
+
+func (p *profileStorageStub) VerifyEmail(_ context.Context, tokenHash string) (string, error) {
+	if p.verification == nil || p.verification.TokenHash != tokenHash {
+		return "", entity.NewErrNotFound("pending email verification")
+	}
+	p.user.EmailVerifiedAt = time.Now()
+	return p.verification.UserId, nil
+}
+
+type emailVerificationNotifierStub struct {
+	token string
+	err   error
+}
+
+func (n *emailVerificationNotifierStub) SendEmailVerification(_ context.Context, _ *entity.EmailVerification, token string) error {
+	n.token = token
+	return n.err
+}
+
+func (s *UserProfileTestSuite) SetupTest() {
+	s.repo = &profileStorageStub{userStorageStub: &userStorageStub{
+		user: &entity.User{Id: "user", Email: "patient@dennic.uz", Status: entity.UserStatusActive, Version: 1},
+	}}
+	s.audit = &auditStorageStub{}
+	s.notifier = &emailVerificationNotifierStub{}
+}
+
+func (s *UserProfileTestSuite) verification(notifier EmailVerificationNotifier) emailVerificationService {
+	return NewEmailVerificationService(time.Second, s.repo, s.audit, NewRoleService(time.Second, nil, 0), notifier, time.Hour)
+}
+
+func (s *UserProfileTestSuite) TestValidateProfile() {
+	var errValidation *entity.ErrValidation
+
+	s.NoError(validateProfile(&entity.User{
+		Email:             "patient@dennic.uz",
+		PreferredLanguage: "ru-RU",
+		Country:           "UZ",
+		AvatarKey:         "avatars/user.png",
+	}))
+
+	err := validateProfile(&entity.User{
+		Email:             "Patient <patient@dennic.uz>",
+		PreferredLanguage: "russian",
+		Country:           "uzb",
+		AvatarKey:         "https://cdn.dennic.uz/avatars/user.png",
+	})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Len(errValidation.Errors, 4)
+}
+
+func (s *UserProfileTestSuite) TestEmergencyContactLimit() {
+	user := NewUserService(time.Second, s.repo, s.audit, NewRoleService(time.Second, nil, 0))
+
+	var errRequired *entity.ErrNoRequiredParameter
+	s.ErrorAs(user.AddEmergencyContact(internalCall(), &entity.EmergencyContact{UserId: "user", FullName: "Mother"}), &errRequired)
+
+	for i := 0; i < entity.MaxEmergencyContacts; i++ {
+		contact := &entity.EmergencyContact{UserId: "user", FullName: "Mother", PhoneNumber: "+998901234567"}
+		s.Require().NoError(user.AddEmergencyContact(internalCall(), contact))
+		s.NotEmpty(contact.Id)
+	}
+	s.Equal(entity.AuditActionAddContact, s.audit.entries[0].Action)
+
+	var errValidation *entity.ErrValidation
+	err := user.AddEmergencyContact(internalCall(), &entity.EmergencyContact{UserId: "user", FullName: "Father", PhoneNumber: "+998901234568"})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "emergency_contacts")
+}
+
+func (s *UserProfileTestSuite) TestEmailVerification() {
+	verification, err := s.verification(s.notifier).Request(internalCall(), "user")
+	s.Require().NoError(err)
+	s.Equal("patient@dennic.uz", verification.Email)
+
+	// only the hash is stored, the token reached the notifier
+	s.Equal(hashToken(s.notifier.token), s.repo.verification.TokenHash)
+
+	userId, err := s.verification(s.notifier).Verify(internalCall(), s.notifier.token)
+	s.Require().NoError(err)
+	s.Equal("user", userId)
+	s.Equal(entity.AuditActionVerifyEmail, s.audit.entries[0].Action)
+
+	var errConflict *entity.ErrConflict
+	_, err = s.verification(s.notifier).Request(internalCall(), "user")
+	s.ErrorAs(err, &errConflict)
+}
+
+func (s *UserProfileTestSuite) TestEmailVerificationNotDelivered() {
+	// the token is never returned, so a verification that can not be sent fails
+	_, err := s.verification(nil).Request(internalCall(), "user")
+	s.Error(err)
+
+	s.notifier.err = errors.New("notification service unavailable")
+	_, err = s.verification(s.notifier).Request(internalCall(), "user")
+	s.ErrorIs(err, s.notifier.err)
+
+	var errNotFound *entity.ErrNotFound
+	_, err = s.verification(s.notifier).Verify(internalCall(), "unknown")
+	s.ErrorAs(err, &errNotFound)
+}
+
+func TestUserProfileTestSuite(t *testing.T) {
+	suite.Run(t, new(UserProfileTestSuite))
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/pkg/otlp"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// userStatusFields are the audited fields of a status change
+var userStatusFields = map[string]func(*entity.User) string{
+	"status":        func(u *entity.User) string { return u.Status },
+	"status_reason": func(u *entity.User) string { return u.StatusReason },
+	"status_expires_at": func(u *entity.User) string {
+		if u.StatusExpiresAt.IsZero() {
+			return ""
+		}
+		return u.StatusExpiresAt.Format(time.RFC3339)
+	},
+}
+
+var userStatusAuditActions = map[string]string{
+	entity.UserStatusActive:    entity.AuditActionActivate,
+	entity.UserStatusSuspended: entity.AuditActionSuspend,
+	entity.UserStatusBlocked:   entity.AuditActionBlock,
+}
+
+// ChangeStatus moves a user to status if the transition is allowed, suspensions and blocks
+// need a reason and lift themselves at expiresAt when it is set, activating clears both
+func (u userService) ChangeStatus(ctx context.Context, id, status, reason string, expiresAt time.Time) (*entity.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, u.ctxTimeout)
+	defer cancel()
+
+	ctx, span := otlp.Start(ctx, UserServiceName, UserSpanName+"ChangeStatus")
+	defer span.End()
+
+	if err := u.authz.Authorize(ctx, entity.PermissionUsersWrite); err != nil {
+		return nil, err
+	}
+
+	user, err := u.repo.Get(ctx, map[string]string{"id": id})
+	if err != nil {
+		return nil, err
+	}
+	before := *user
+
+	if err := validateStatusChange(user.Status, status, reason, expiresAt, time.Now()); err != nil {
+		return nil, err
+	}
+	user.Status = status
+	user.StatusReason = reason
+	user.StatusExpiresAt = expiresAt
+	if status == entity.UserStatusActive {
+		user.StatusReason = ""
+		user.StatusExpiresAt = time.Time{}
+	}
+
+	if err := u.repo.SetStatus(ctx, user); err != nil {
+		return nil, err
+	}
+	if status != entity.UserStatusActive {
+		user.RefreshToken = ""
+	}
+
+	u.audit.record(ctx, entity.AuditEntityUser, id, userStatusAuditActions[status],
+		auditChanges(fieldNames(userStatusFields), userStatusFields, &before, user))
+
+	return user, nil
+}
+
+func validateStatusChange(from, to, reason string, expiresAt, now time.Time) error {
+	errValidation := entity.NewErrValidation()
+
+	allowed := false
+	for _, status := range entity.UserStatusTransitions[from] {
+		if status == to {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		errValidation.Errors["status"] = fmt.Sprintf("a %s user can not become %s", from, to)
+	}
+	if to != entity.UserStatusActive {
+		if reason == "" {
+			errValidation.Errors["reason"] = "reason is required"
+		}
+		if !expiresAt.IsZero() && !expiresAt.After(now) {
+			errValidation.Errors["expires_at"] = "expires_at must be in the future"
+		}
+	}
+
+	if len(errValidation.Errors) != 0 {
+		errValidation.Err = errors.New("invalid status change")
+		return errValidation
+	}
+	return nil
+}
+
+// validateSignupStatus allows a new user to start pending until it is verified, active by default
+func validateSignupStatus(user *entity.User) error {
+	switch user.Status {
+	case "":
+		user.Status = entity.UserStatusActive
+	case entity.UserStatusPending, entity.UserStatusActive:
+	default:
+		errValidation := entity.NewErrValidation()
+		errValidation.Err = fmt.Errorf("invalid signup status %q", user.Status)
+		errValidation.Errors["status"] = "a new user is pending or active"
+		return errValidation
+	}
+	return nil
+}
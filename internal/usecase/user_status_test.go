@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"dennic_user_service/internal/entity"
+	"dennic_user_service/internal/infrastructure/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UserStatusTestSuite struct {
+	suite.Suite
+	repo  *userStorageStub
+	audit *auditStorageStub
+	user  userService
+}
+
+// userStorageStub keeps a single user and the last status written
+type userStorageStub struct {
+	repository.UserStorageI
+	user *entity.User
+}
+
+func (u *userStorageStub) Get(context.Context, map[string]string) (*entity.User, error) {
+	user := *u.user
+	return &user, nil
+}
+
+func (u *userStorageStub) SetStatus(_ context.Context, user *entity.User) error {
+	user.Version++
+	u.user = user
+	return nil
+}
+
+func (s *UserStatusTestSuite) SetupTest() {
+	s.repo = &userStorageStub{user: &entity.User{Id: "user", Status: entity.UserStatusActive, RefreshToken: "token", Version: 1}}
+	s.audit = &auditStorageStub{}
+	s.user = NewUserService(time.Second, s.repo, s.audit, NewRoleService(time.Second, nil, 0))
+}
+
+func (s *UserStatusTestSuite) TestSuspendAndActivate() {
+	expiresAt := time.Now().Add(time.Hour)
+
+	user, err := s.user.ChangeStatus(internalCall(), "user", entity.UserStatusSuspended, "spam", expiresAt)
+	s.Require().NoError(err)
+	s.Equal(entity.UserStatusSuspended, s.repo.user.Status)
+	s.Equal("spam", s.repo.user.StatusReason)
+	s.Equal(expiresAt, s.repo.user.StatusExpiresAt)
+	s.Empty(user.RefreshToken)
+	s.Equal(entity.AuditActionSuspend, s.audit.entries[0].Action)
+
+	_, err = s.user.ChangeStatus(internalCall(), "user", entity.UserStatusActive, "", time.Time{})
+	s.Require().NoError(err)
+	s.Equal(entity.UserStatusActive, s.repo.user.Status)
+	s.Empty(s.repo.user.StatusReason)
+	s.True(s.repo.user.StatusExpiresAt.IsZero())
+}
+
+func (s *UserStatusTestSuite) TestTransitions() {
+	var errValidation *entity.ErrValidation
+
+	s.repo.user.Status = entity.UserStatusBlocked
+	_, err := s.user.ChangeStatus(internalCall(), "user", entity.UserStatusSuspended, "spam", time.Time{})
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "status")
+
+	s.repo.user.Status = entity.UserStatusPending
+	_, err = s.user.ChangeStatus(internalCall(), "user", entity.UserStatusActive, "", time.Time{})
+	s.NoError(err)
+}
+
+func (s *UserStatusTestSuite) TestReasonAndExpiry() {
+	var errValidation *entity.ErrValidation
+
+	_, err := s.user.ChangeStatus(internalCall(), "user", entity.UserStatusBlocked, "", time.Now().Add(-time.Hour))
+	s.Require().ErrorAs(err, &errValidation)
+	s.Contains(errValidation.Errors, "reason")
+	s.Contains(errValidation.Errors, "expires_at")
+	s.Equal(entity.UserStatusActive, s.repo.user.Status)
+}
+
+func (s *UserStatusTestSuite) TestSignupStatus() {
+	user := &entity.User{}
+	s.NoError(validateSignupStatus(user))
+	s.Equal(entity.UserStatusActive, user.Status)
+
+	s.NoError(validateSignupStatus(&entity.User{Status: entity.UserStatusPending}))
+
+	var errValidation *entity.ErrValidation
+	s.ErrorAs(validateSignupStatus(&entity.User{Status: entity.UserStatusBlocked}), &errValidation)
+}
+
+func TestUserStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(UserStatusTestSuite))
+}
@@ -1 +1,2 @@
 DROP TABLE IF EXISTS users;
+DROP TYPE IF EXISTS gender_type;
@@ -1 +1,2 @@
-DROP TABLE IF EXISTS admins;
\ No newline at end of file
+DROP TABLE IF EXISTS admins;
+DROP TYPE IF EXISTS role_type;
@@ -24,4 +24,4 @@ CREATE TABLE IF NOT EXISTS admins (
 );
 
 CREATE UNIQUE INDEX admin_unique_phone_number_deleted_at_null_idx ON admins(phone_number) WHERE deleted_at IS NULL; --unique admin phone number, users with non-soft-deleted accounts cannot register again with the same phone number.
-CREATE INDEX deleted_at_idx ON admins(deleted_at);
+CREATE INDEX admin_deleted_at_idx ON admins(deleted_at);
@@ -1 +1 @@
-DELETE FROM users;
\ No newline at end of file
+-- seed data moved to the opt-in development fixtures in migrations/fixtures
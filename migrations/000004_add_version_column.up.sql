@@ -0,0 +1,3 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
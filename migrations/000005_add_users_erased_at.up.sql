@@ -0,0 +1,2 @@
+-- erased users keep their id so other services can still resolve references, their personal data is overwritten
+ALTER TABLE users ADD COLUMN IF NOT EXISTS erased_at TIMESTAMP;
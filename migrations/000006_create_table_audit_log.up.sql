@@ -0,0 +1,16 @@
+/*audit log of every mutation on users and admins*/
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    actor_id VARCHAR(100) NOT NULL DEFAULT '',
+    actor_type VARCHAR(20) NOT NULL DEFAULT '',
+    request_id VARCHAR(100) NOT NULL DEFAULT '',
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX audit_log_entity_idx ON audit_log(entity_type, entity_id, created_at);
+CREATE INDEX audit_log_actor_idx ON audit_log(actor_id, created_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS admin_role_history;
+DROP TABLE IF EXISTS admin_roles;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,40 @@
+/*roles grant permissions such as users.read to admins, "*" grants every permission*/
+CREATE TABLE IF NOT EXISTS roles (
+    id UUID NOT NULL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    built_in BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+/*every admin holds the built-in role named by admins.role in addition to the assigned roles*/
+INSERT INTO roles (id, name, description, permissions, built_in)
+VALUES
+    ('7f0f5a3e-3a57-4a55-9a3c-0c5f7e6b0001', 'superadmin', 'Full access', '{*}', TRUE),
+    ('7f0f5a3e-3a57-4a55-9a3c-0c5f7e6b0002', 'admin', 'Manage users', '{users.read,users.write,users.delete,admins.read,roles.read}', TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS admin_roles (
+    admin_id UUID NOT NULL REFERENCES admins(id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    assigned_by VARCHAR(100) NOT NULL DEFAULT '',
+    assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (admin_id, role_id)
+);
+
+CREATE INDEX admin_roles_role_id_idx ON admin_roles(role_id);
+
+/*history of role assignments, kept when the role is deleted*/
+CREATE TABLE IF NOT EXISTS admin_role_history (
+    id BIGSERIAL PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    role_id UUID NOT NULL,
+    role_name VARCHAR(50) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    actor_id VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX admin_role_history_admin_id_idx ON admin_role_history(admin_id, created_at);
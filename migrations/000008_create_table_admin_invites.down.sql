@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admin_invites;
+ALTER TABLE admins DROP COLUMN IF EXISTS activated_at;
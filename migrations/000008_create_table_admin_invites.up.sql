@@ -0,0 +1,19 @@
+/*invited admins are pending until they accept the invite and choose a password*/
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS activated_at TIMESTAMP;
+UPDATE admins SET activated_at = created_at WHERE activated_at IS NULL;
+
+/*single-use invites, only the sha256 hash of the token is stored*/
+CREATE TABLE IF NOT EXISTS admin_invites (
+    id UUID NOT NULL PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    channel VARCHAR(10) NOT NULL,
+    recipient VARCHAR(100) NOT NULL,
+    invited_by VARCHAR(100) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX admin_invites_admin_id_idx ON admin_invites(admin_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admin_salary_history;
+ALTER TABLE admins DROP COLUMN IF EXISTS terminated_at;
@@ -0,0 +1,15 @@
+/*terminated admins keep their account for the record but can not log in*/
+ALTER TABLE admins ADD COLUMN IF NOT EXISTS terminated_at TIMESTAMP;
+
+/*every salary an admin had, admins.salary holds the current one*/
+CREATE TABLE IF NOT EXISTS admin_salary_history (
+    id BIGSERIAL PRIMARY KEY,
+    admin_id UUID NOT NULL REFERENCES admins(id) ON DELETE CASCADE,
+    salary FLOAT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX admin_salary_history_admin_id_idx ON admin_salary_history(admin_id, created_at);
+
+INSERT INTO admin_salary_history (admin_id, salary, created_at)
+SELECT id, salary, COALESCE(updated_at, created_at) FROM admins;
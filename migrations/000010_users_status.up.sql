@@ -0,0 +1,7 @@
+/*pending signups are not verified yet, suspended and blocked accounts can not log in until the status expires or an admin activates them*/
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('pending', 'active', 'suspended', 'blocked'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status_expires_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS users_status_idx ON users(status) WHERE deleted_at IS NULL;
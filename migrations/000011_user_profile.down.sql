@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS user_emergency_contacts;
+DROP TABLE IF EXISTS user_email_verifications;
+DROP INDEX IF EXISTS unique_email_deleted_at_null_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_key;
+ALTER TABLE users DROP COLUMN IF EXISTS country;
+ALTER TABLE users DROP COLUMN IF EXISTS city;
+ALTER TABLE users DROP COLUMN IF EXISTS address_line;
+ALTER TABLE users DROP COLUMN IF EXISTS preferred_language;
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified_at;
+ALTER TABLE users DROP COLUMN IF EXISTS email;
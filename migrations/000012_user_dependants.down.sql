@@ -0,0 +1,2 @@
+/*the phone index keeps skipping empty numbers, dependants stay behind as users without a phone number*/
+DROP TABLE IF EXISTS user_dependants;
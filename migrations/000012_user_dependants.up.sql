@@ -0,0 +1,15 @@
+/*dependants are users without credentials, so several of them may have no phone number*/
+DROP INDEX IF EXISTS unique_phone_number_deleted_at_null_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_phone_number_deleted_at_null_idx ON users(phone_number) WHERE deleted_at IS NULL AND phone_number <> '';
+
+/*a dependant (a child, an elderly parent) has exactly one guardian who acts on its behalf*/
+CREATE TABLE IF NOT EXISTS user_dependants (
+    dependant_id UUID NOT NULL PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    guardian_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    relationship VARCHAR(20) NOT NULL CHECK (relationship IN ('child', 'parent', 'spouse', 'sibling', 'other')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (dependant_id <> guardian_id)
+);
+
+CREATE INDEX user_dependants_guardian_id_idx ON user_dependants(guardian_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS medical_profiles;
@@ -0,0 +1,15 @@
+/*medical data of patients, every value column is encrypted with the data key of its row,
+data_key is sealed with the key encryption key key_id from the configuration*/
+CREATE TABLE IF NOT EXISTS medical_profiles (
+    user_id UUID NOT NULL PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    key_id VARCHAR(50) NOT NULL,
+    data_key BYTEA NOT NULL,
+    blood_type BYTEA NOT NULL,
+    allergies BYTEA NOT NULL,
+    chronic_conditions BYTEA NOT NULL,
+    medications BYTEA NOT NULL,
+    version BIGINT NOT NULL DEFAULT 1,
+    updated_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
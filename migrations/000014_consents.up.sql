@@ -0,0 +1,28 @@
+/*published versions of the documents users consent to, a version is never changed once published*/
+CREATE TABLE IF NOT EXISTS consent_documents (
+    type VARCHAR(20) NOT NULL CHECK (type IN ('terms', 'privacy_policy', 'data_sharing')),
+    version BIGINT NOT NULL CHECK (version > 0),
+    url VARCHAR(255) NOT NULL DEFAULT '',
+    content_hash VARCHAR(64) NOT NULL DEFAULT '',
+    published_by VARCHAR(100) NOT NULL DEFAULT '',
+    published_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (type, version)
+);
+
+/*the documents in force before consents were recorded*/
+INSERT INTO consent_documents (type, version) VALUES ('terms', 1), ('privacy_policy', 1), ('data_sharing', 1)
+ON CONFLICT DO NOTHING;
+
+/*every acceptance of a document, withdrawn consents are kept as proof*/
+CREATE TABLE IF NOT EXISTS user_consents (
+    id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    document_type VARCHAR(20) NOT NULL,
+    document_version BIGINT NOT NULL,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    accepted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    withdrawn_at TIMESTAMP,
+    FOREIGN KEY (document_type, document_version) REFERENCES consent_documents(type, version)
+);
+
+CREATE INDEX user_consents_user_id_idx ON user_consents(user_id, accepted_at);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS user_duplicates;
+DROP INDEX IF EXISTS users_birth_date_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS merged_into;
@@ -0,0 +1,17 @@
+/*a merged account is soft-deleted and points at the account that absorbed it*/
+ALTER TABLE users ADD COLUMN IF NOT EXISTS merged_into UUID REFERENCES users(id) ON DELETE SET NULL;
+
+/*live users sharing a birth date are compared by name*/
+CREATE INDEX IF NOT EXISTS users_birth_date_idx ON users(birth_date) WHERE deleted_at IS NULL;
+
+/*pairs of users that are likely the same person, replaced by every run of the duplicate search*/
+CREATE TABLE IF NOT EXISTS user_duplicates (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    duplicate_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    score REAL NOT NULL,
+    found_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, duplicate_id),
+    CHECK (user_id < duplicate_id)
+);
+
+CREATE INDEX user_duplicates_score_idx ON user_duplicates(score DESC);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS user_consents_subject_id_idx;
+/*consents of purged users have no user to cascade from*/
+DELETE FROM user_consents WHERE user_id IS NULL;
+ALTER TABLE user_consents DROP CONSTRAINT IF EXISTS user_consents_user_id_fkey;
+ALTER TABLE user_consents ADD CONSTRAINT user_consents_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE user_consents ALTER COLUMN user_id SET NOT NULL;
+ALTER TABLE user_consents DROP COLUMN IF EXISTS subject_id;
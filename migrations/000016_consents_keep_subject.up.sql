@@ -0,0 +1,10 @@
+/*consents are proof and outlive the account, subject_id keeps who gave them once the user is purged or merged away*/
+ALTER TABLE user_consents ADD COLUMN IF NOT EXISTS subject_id UUID;
+UPDATE user_consents SET subject_id = user_id WHERE subject_id IS NULL;
+ALTER TABLE user_consents ALTER COLUMN subject_id SET NOT NULL;
+
+ALTER TABLE user_consents ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE user_consents DROP CONSTRAINT IF EXISTS user_consents_user_id_fkey;
+ALTER TABLE user_consents ADD CONSTRAINT user_consents_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS user_consents_subject_id_idx ON user_consents(subject_id);
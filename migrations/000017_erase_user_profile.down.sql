@@ -0,0 +1,3 @@
+/*the gender of erased users is gone, the column needs a value again*/
+UPDATE users SET gender = 'male' WHERE gender IS NULL;
+ALTER TABLE users ALTER COLUMN gender SET NOT NULL;
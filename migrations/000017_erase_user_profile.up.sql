@@ -0,0 +1,4 @@
+/*gender is personal data an erasure has to blank, only erased users have none*/
+ALTER TABLE users ALTER COLUMN gender DROP NOT NULL;
+
+UPDATE users SET gender = NULL, country = '', preferred_language = '' WHERE erased_at IS NOT NULL;
@@ -0,0 +1,14 @@
+// Package migrations embeds the versioned schema migrations and the development fixtures
+package migrations
+
+import "embed"
+
+// FS holds {version}_{name}.up.sql and {version}_{name}.down.sql files
+//
+//go:embed *.sql
+var FS embed.FS
+
+// Fixtures holds sample data that is only loaded on demand, never by a migration
+//
+//go:embed fixtures/*.sql
+var Fixtures embed.FS